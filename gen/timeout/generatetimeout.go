@@ -0,0 +1,275 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+)
+
+// wrappedInterfaces lists the interfaces that are decorated with a timeout.
+// Interface is decorated by timeoutInterface, which is declared in timeout.go.
+// The remaining interfaces are the handle types that its methods return; their
+// decorators are declared in the generated code so that every handle obtained
+// through a timeoutInterface is subject to the same timeout.
+var wrappedInterfaces = []string{
+	"Interface",
+	"Device",
+	"GpuInstance",
+	"ComputeInstance",
+	"EventSet",
+	"GpmSample",
+	"Unit",
+	"VgpuInstance",
+	"VgpuTypeId",
+}
+
+// isHandle returns whether typ is one of the decorated handle types.
+func isHandle(typ string) bool {
+	for _, iface := range wrappedInterfaces[1:] {
+		if typ == iface {
+			return true
+		}
+	}
+	return false
+}
+
+// decoratorName returns the name of the type that decorates iface.
+func decoratorName(iface string) string {
+	return "timeout" + iface
+}
+
+func main() {
+	input := flag.String("input", "", "Path to the generated API file defining the interfaces")
+	output := flag.String("output", "", "Path to the output file (default: stdout)")
+	flag.Parse()
+
+	if *input == "" {
+		flag.Usage()
+		return
+	}
+
+	source, err := generate(*input)
+	if err != nil {
+		fmt.Printf("Error: %v", err)
+		os.Exit(1)
+	}
+
+	if *output == "" {
+		fmt.Print(string(source))
+		return
+	}
+	if err := os.WriteFile(*output, source, 0644); err != nil {
+		fmt.Printf("Error: %v", err)
+		os.Exit(1)
+	}
+}
+
+func generate(input string) ([]byte, error) {
+	fset := token.NewFileSet()
+	node, err := parser.ParseFile(fset, input, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces := make(map[string]*ast.InterfaceType)
+	ast.Inspect(node, func(n ast.Node) bool {
+		spec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		if iface, ok := spec.Type.(*ast.InterfaceType); ok {
+			interfaces[spec.Name.Name] = iface
+		}
+		return false
+	})
+
+	var methods bytes.Buffer
+	slices := make(map[string]bool)
+	for _, name := range wrappedInterfaces {
+		iface, ok := interfaces[name]
+		if !ok {
+			return nil, fmt.Errorf("interface %s not found in %s", name, input)
+		}
+		for _, method := range iface.Methods.List {
+			funcType, ok := method.Type.(*ast.FuncType)
+			if !ok || len(method.Names) != 1 {
+				continue
+			}
+			writeMethod(&methods, name, method.Names[0].Name, funcType, slices)
+		}
+	}
+
+	var b bytes.Buffer
+	b.WriteString(header)
+	for _, name := range wrappedInterfaces[1:] {
+		writeHandle(&b, name, slices[name])
+	}
+	b.Write(methods.Bytes())
+
+	return format.Source(b.Bytes())
+}
+
+// writeHandle writes the decorator of a handle type together with the
+// functions used to wrap the handles returned by decorated methods. The
+// function wrapping a list of handles is only written if such a list is
+// returned.
+func writeHandle(b *bytes.Buffer, iface string, slice bool) {
+	typ := decoratorName(iface)
+	fmt.Fprintf(b, "// %s decorates a %s with a timeout for each call.\n", typ, iface)
+	fmt.Fprintf(b, "type %s struct {\n\t%s\n\ttimeout time.Duration\n}\n\n", typ, iface)
+	fmt.Fprintf(b, "func (t %s) unwrapTimeout() %s {\n\treturn t.%s\n}\n\n", typ, iface, iface)
+	fmt.Fprintf(b, "func wrap%sWithTimeout(handle %s, timeout time.Duration) %s {\n", iface, iface, iface)
+	fmt.Fprintf(b, "\tif handle == nil {\n\t\treturn nil\n\t}\n")
+	fmt.Fprintf(b, "\tif _, ok := handle.(%s); ok {\n\t\treturn handle\n\t}\n", typ)
+	fmt.Fprintf(b, "\treturn %s{%s: handle, timeout: timeout}\n}\n\n", typ, iface)
+	if !slice {
+		return
+	}
+	fmt.Fprintf(b, "func wrap%ssWithTimeout(handles []%s, timeout time.Duration) []%s {\n", iface, iface, iface)
+	fmt.Fprintf(b, "\tif handles == nil {\n\t\treturn nil\n\t}\n")
+	fmt.Fprintf(b, "\twrapped := make([]%s, len(handles))\n", iface)
+	fmt.Fprintf(b, "\tfor i, handle := range handles {\n\t\twrapped[i] = wrap%sWithTimeout(handle, timeout)\n\t}\n", iface)
+	fmt.Fprintf(b, "\treturn wrapped\n}\n\n")
+}
+
+// writeMethod writes a method of the decorating type that calls the wrapped
+// method through CallWithTimeout. Handles passed as arguments are unwrapped
+// so that the call is not timed twice and the wrapped implementation receives
+// the handle it created. Methods that do not return a Return are left to the
+// embedded interface. The handle types of any returned lists are recorded in
+// slices.
+func writeMethod(b *bytes.Buffer, iface string, name string, funcType *ast.FuncType, slices map[string]bool) {
+	var results []string
+	if funcType.Results != nil {
+		for _, field := range funcType.Results.List {
+			results = append(results, typeString(field.Type))
+		}
+	}
+	if len(results) == 0 || results[len(results)-1] != "Return" {
+		return
+	}
+	values := results[:len(results)-1]
+	for _, value := range values {
+		if elem := strings.TrimPrefix(value, "[]"); elem != value && isHandle(elem) {
+			slices[elem] = true
+		}
+	}
+
+	var params, args []string
+	if funcType.Params != nil {
+		for i, field := range funcType.Params.List {
+			arg := fmt.Sprintf("p%d", i)
+			typ := typeString(field.Type)
+			params = append(params, arg+" "+typ)
+			if isHandle(typ) {
+				arg = fmt.Sprintf("unwrapTimeout(%s)", arg)
+			}
+			args = append(args, arg)
+		}
+	}
+	call := fmt.Sprintf("t.%s.%s(%s)", iface, name, strings.Join(args, ", "))
+
+	fmt.Fprintf(b, "func (t %s) %s(%s) ", decoratorName(iface), name, strings.Join(params, ", "))
+	if len(results) > 1 {
+		fmt.Fprintf(b, "(%s) {\n", strings.Join(results, ", "))
+	} else {
+		fmt.Fprintf(b, "%s {\n", results[0])
+	}
+
+	switch len(values) {
+	case 0:
+		fmt.Fprintf(b, "\treturn WithTimeout(t.timeout, func() Return {\n\t\treturn %s\n\t})\n", call)
+	case 1:
+		fmt.Fprintf(b, "\tr, ret := CallWithTimeout(t.timeout, func() (%s, Return) {\n\t\treturn %s\n\t})\n", values[0], call)
+		fmt.Fprintf(b, "\treturn %s, ret\n", wrapResult("r", values[0]))
+	default:
+		var fields, names, returned []string
+		for i, value := range values {
+			field := fmt.Sprintf("r%d", i)
+			fields = append(fields, field+" "+value)
+			names = append(names, field)
+			returned = append(returned, wrapResult("r."+field, value))
+		}
+		tuple := fmt.Sprintf("struct {\n\t\t%s\n\t}", strings.Join(fields, "\n\t\t"))
+		fmt.Fprintf(b, "\tr, ret := CallWithTimeout(t.timeout, func() (%s, Return) {\n", tuple)
+		fmt.Fprintf(b, "\t\t%s, ret := %s\n", strings.Join(names, ", "), call)
+		fmt.Fprintf(b, "\t\treturn %s{%s}, ret\n\t})\n", tuple, strings.Join(names, ", "))
+		fmt.Fprintf(b, "\treturn %s, ret\n", strings.Join(returned, ", "))
+	}
+	b.WriteString("}\n\n")
+}
+
+// wrapResult wraps returned handles, and lists of handles, so that calls made
+// on them are subject to the same timeout.
+func wrapResult(value string, typ string) string {
+	if isHandle(typ) {
+		return fmt.Sprintf("wrap%sWithTimeout(%s, t.timeout)", typ, value)
+	}
+	if elem := strings.TrimPrefix(typ, "[]"); elem != typ && isHandle(elem) {
+		return fmt.Sprintf("wrap%ssWithTimeout(%s, t.timeout)", elem, value)
+	}
+	return value
+}
+
+func typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.ArrayType:
+		return "[]" + typeString(t.Elt)
+	case *ast.StarExpr:
+		return "*" + typeString(t.X)
+	case *ast.SelectorExpr:
+		return typeString(t.X) + "." + t.Sel.Name
+	default:
+		panic(fmt.Sprintf("unsupported type expression %T", expr))
+	}
+}
+
+const header = `/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvml
+
+import (
+	"time"
+)
+
+`
@@ -0,0 +1,60 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"sort"
+)
+
+// GroupByBoard groups devices by the board they are on, keyed by the id
+// reported by GetBoardId. Devices whose board id cannot be read are grouped
+// under board id 0. Within each group, devices are ordered by the module id
+// reported by GetModuleId, which tells apart the modules of multi-module
+// boards; devices without a module id follow in their original order.
+func GroupByBoard(devices []Device) map[uint32][]Device {
+	type member struct {
+		device      Device
+		moduleId    int
+		hasModuleId bool
+	}
+
+	members := make(map[uint32][]member)
+	for _, device := range devices {
+		boardId, ret := device.GetBoardId()
+		if ret != SUCCESS {
+			boardId = 0
+		}
+		moduleId, ret := device.GetModuleId()
+		members[boardId] = append(members[boardId], member{device, moduleId, ret == SUCCESS})
+	}
+
+	groups := make(map[uint32][]Device, len(members))
+	for boardId, m := range members {
+		sort.SliceStable(m, func(i, j int) bool {
+			if m[i].hasModuleId != m[j].hasModuleId {
+				return m[i].hasModuleId
+			}
+			return m[i].hasModuleId && m[i].moduleId < m[j].moduleId
+		})
+		group := make([]Device, len(m))
+		for i := range m {
+			group[i] = m[i].device
+		}
+		groups[boardId] = group
+	}
+	return groups
+}
@@ -0,0 +1,72 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestGroupByBoard(t *testing.T) {
+	newDevice := func(uuid string, boardId uint32, boardRet nvml.Return, moduleId int, moduleRet nvml.Return) nvml.Device {
+		return &mock.Device{
+			GetUUIDFunc: func() (string, nvml.Return) {
+				return uuid, nvml.SUCCESS
+			},
+			GetBoardIdFunc: func() (uint32, nvml.Return) {
+				return boardId, boardRet
+			},
+			GetModuleIdFunc: func() (int, nvml.Return) {
+				return moduleId, moduleRet
+			},
+		}
+	}
+
+	devices := []nvml.Device{
+		newDevice("GPU-0", 0x100, nvml.SUCCESS, 3, nvml.SUCCESS),
+		newDevice("GPU-1", 0x200, nvml.SUCCESS, 0, nvml.ERROR_NOT_SUPPORTED),
+		newDevice("GPU-2", 0x100, nvml.SUCCESS, 1, nvml.SUCCESS),
+		newDevice("GPU-3", 0, nvml.ERROR_NOT_SUPPORTED, 0, nvml.ERROR_NOT_SUPPORTED),
+		newDevice("GPU-4", 0x100, nvml.SUCCESS, 0, nvml.ERROR_NOT_SUPPORTED),
+		newDevice("GPU-5", 0x200, nvml.SUCCESS, 0, nvml.ERROR_NOT_SUPPORTED),
+		newDevice("GPU-6", 0, nvml.ERROR_NOT_SUPPORTED, 2, nvml.SUCCESS),
+	}
+
+	groups := nvml.GroupByBoard(devices)
+
+	uuids := make(map[uint32][]string)
+	for boardId, group := range groups {
+		for _, device := range group {
+			uuid, ret := device.GetUUID()
+			require.Equal(t, nvml.SUCCESS, ret)
+			uuids[boardId] = append(uuids[boardId], uuid)
+		}
+	}
+	require.Equal(t, map[uint32][]string{
+		0x100: {"GPU-2", "GPU-0", "GPU-4"},
+		0x200: {"GPU-1", "GPU-5"},
+		0:     {"GPU-6", "GPU-3"},
+	}, uuids)
+}
+
+func TestGroupByBoardEmpty(t *testing.T) {
+	require.Empty(t, nvml.GroupByBoard(nil))
+}
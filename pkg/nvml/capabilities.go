@@ -0,0 +1,101 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+// DeviceCapabilities reports which optional features a device supports.
+type DeviceCapabilities struct {
+	MIG                 bool
+	GPM                 bool
+	NvLink              bool
+	ConfidentialCompute bool
+	ECC                 bool
+}
+
+// Capabilities probes which optional features a device supports. Probes that
+// return ERROR_NOT_SUPPORTED (or ERROR_FUNCTION_NOT_FOUND on older drivers)
+// report the feature as unsupported; any other error is returned.
+//
+// A feature is reported as supported if it can be queried, regardless of
+// whether it is currently enabled; e.g. MIG is true for a MIG-capable GPU with
+// MIG mode disabled. Confidential compute support is a property of the system
+// and is derived from SystemGetConfComputeCapabilities.
+func Capabilities(lib Interface, device Device) (DeviceCapabilities, Return) {
+	var capabilities DeviceCapabilities
+	var ret Return
+
+	_, _, ret = device.GetMigMode()
+	if capabilities.MIG, ret = available(ret); ret != SUCCESS {
+		return capabilities, ret
+	}
+
+	gpmSupport, ret := device.GpmQueryDeviceSupport()
+	if capabilities.GPM, ret = available(ret); ret != SUCCESS {
+		return capabilities, ret
+	}
+	capabilities.GPM = capabilities.GPM && gpmSupport.IsSupportedDevice != 0
+
+	for link := 0; link < NVLINK_MAX_LINKS; link++ {
+		_, ret = device.GetNvLinkState(link)
+		// Links beyond those present on the device are reported as invalid.
+		if ret == ERROR_INVALID_ARGUMENT {
+			break
+		}
+		if capabilities.NvLink, ret = available(ret); ret != SUCCESS {
+			return capabilities, ret
+		}
+		if capabilities.NvLink {
+			break
+		}
+	}
+
+	ccCaps, ret := lib.SystemGetConfComputeCapabilities()
+	if capabilities.ConfidentialCompute, ret = available(ret); ret != SUCCESS {
+		return capabilities, ret
+	}
+	capabilities.ConfidentialCompute = capabilities.ConfidentialCompute && ccCaps.GpusCaps == CC_SYSTEM_GPUS_CC_CAPABLE
+
+	_, _, ret = device.GetEccMode()
+	if capabilities.ECC, ret = available(ret); ret != SUCCESS {
+		return capabilities, ret
+	}
+
+	return capabilities, SUCCESS
+}
+
+// featureMatrix lists the optional features available on at least some
+// devices of each architecture.
+var featureMatrix = map[DeviceArchitecture]DeviceCapabilities{
+	DEVICE_ARCH_KEPLER:  {ECC: true},
+	DEVICE_ARCH_MAXWELL: {ECC: true},
+	DEVICE_ARCH_PASCAL:  {NvLink: true, ECC: true},
+	DEVICE_ARCH_VOLTA:   {NvLink: true, ECC: true},
+	DEVICE_ARCH_TURING:  {NvLink: true, ECC: true},
+	DEVICE_ARCH_AMPERE:  {MIG: true, NvLink: true, ECC: true},
+	DEVICE_ARCH_ADA:     {ECC: true},
+	DEVICE_ARCH_HOPPER:  {MIG: true, GPM: true, NvLink: true, ConfidentialCompute: true, ECC: true},
+}
+
+// FeatureMatrix returns the optional features that devices of the specified
+// architecture, as reported by GetArchitecture, can be expected to support.
+// It is based on a static table and does not query any device, which makes it
+// suitable for planning before Capabilities probes a device at runtime. A
+// feature is reported if at least some products of the architecture support
+// it, so Capabilities may still report it as unsupported for a given device.
+// Unknown architectures report no features.
+func FeatureMatrix(arch DeviceArchitecture) DeviceCapabilities {
+	return featureMatrix[arch]
+}
@@ -0,0 +1,157 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestCapabilities(t *testing.T) {
+	testCases := []struct {
+		description          string
+		migRet               nvml.Return
+		gpmSupported         uint32
+		gpmRet               nvml.Return
+		activeNvLinks        int
+		presentNvLinks       int
+		ccCaps               uint32
+		ccRet                nvml.Return
+		eccRet               nvml.Return
+		expectedCapabilities nvml.DeviceCapabilities
+		expectedRet          nvml.Return
+	}{
+		{
+			description:    "datacenter GPU",
+			gpmSupported:   1,
+			activeNvLinks:  12,
+			presentNvLinks: 12,
+			ccCaps:         nvml.CC_SYSTEM_GPUS_CC_CAPABLE,
+			expectedCapabilities: nvml.DeviceCapabilities{
+				MIG:                 true,
+				GPM:                 true,
+				NvLink:              true,
+				ConfidentialCompute: true,
+				ECC:                 true,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description:    "consumer GPU",
+			migRet:         nvml.ERROR_NOT_SUPPORTED,
+			gpmRet:         nvml.ERROR_NOT_SUPPORTED,
+			presentNvLinks: 4,
+			ccRet:          nvml.ERROR_FUNCTION_NOT_FOUND,
+			eccRet:         nvml.ERROR_NOT_SUPPORTED,
+			expectedRet:    nvml.SUCCESS,
+		},
+		{
+			description:    "GPM query succeeds for unsupported device",
+			migRet:         nvml.ERROR_NOT_SUPPORTED,
+			gpmSupported:   0,
+			presentNvLinks: 0,
+			ccCaps:         nvml.CC_SYSTEM_GPUS_CC_NOT_CAPABLE,
+			expectedCapabilities: nvml.DeviceCapabilities{
+				ECC: true,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "probe error",
+			migRet:      nvml.ERROR_GPU_IS_LOST,
+			expectedRet: nvml.ERROR_GPU_IS_LOST,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			device := &mock.Device{
+				GetMigModeFunc: func() (int, int, nvml.Return) {
+					return nvml.DEVICE_MIG_DISABLE, nvml.DEVICE_MIG_DISABLE, tc.migRet
+				},
+				GpmQueryDeviceSupportFunc: func() (nvml.GpmSupport, nvml.Return) {
+					return nvml.GpmSupport{IsSupportedDevice: tc.gpmSupported}, tc.gpmRet
+				},
+				GetNvLinkStateFunc: func(link int) (nvml.EnableState, nvml.Return) {
+					switch {
+					case link >= tc.presentNvLinks:
+						return nvml.FEATURE_DISABLED, nvml.ERROR_INVALID_ARGUMENT
+					case link >= tc.activeNvLinks:
+						return nvml.FEATURE_DISABLED, nvml.ERROR_NOT_SUPPORTED
+					default:
+						return nvml.FEATURE_ENABLED, nvml.SUCCESS
+					}
+				},
+				GetEccModeFunc: func() (nvml.EnableState, nvml.EnableState, nvml.Return) {
+					return nvml.FEATURE_ENABLED, nvml.FEATURE_ENABLED, tc.eccRet
+				},
+			}
+			lib := &mock.Interface{
+				SystemGetConfComputeCapabilitiesFunc: func() (nvml.ConfComputeSystemCaps, nvml.Return) {
+					return nvml.ConfComputeSystemCaps{GpusCaps: tc.ccCaps}, tc.ccRet
+				},
+			}
+
+			capabilities, ret := nvml.Capabilities(lib, device)
+			require.Equal(t, tc.expectedRet, ret)
+			if tc.expectedRet == nvml.SUCCESS {
+				require.Equal(t, tc.expectedCapabilities, capabilities)
+			}
+		})
+	}
+}
+
+func TestFeatureMatrix(t *testing.T) {
+	testCases := []struct {
+		arch     nvml.DeviceArchitecture
+		expected nvml.DeviceCapabilities
+	}{
+		{
+			arch: nvml.DEVICE_ARCH_HOPPER,
+			expected: nvml.DeviceCapabilities{
+				MIG:                 true,
+				GPM:                 true,
+				NvLink:              true,
+				ConfidentialCompute: true,
+				ECC:                 true,
+			},
+		},
+		{
+			arch:     nvml.DEVICE_ARCH_AMPERE,
+			expected: nvml.DeviceCapabilities{MIG: true, NvLink: true, ECC: true},
+		},
+		{
+			arch:     nvml.DEVICE_ARCH_ADA,
+			expected: nvml.DeviceCapabilities{ECC: true},
+		},
+		{
+			arch:     nvml.DEVICE_ARCH_UNKNOWN,
+			expected: nvml.DeviceCapabilities{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("arch %d", tc.arch), func(t *testing.T) {
+			require.Equal(t, tc.expected, nvml.FeatureMatrix(tc.arch))
+		})
+	}
+}
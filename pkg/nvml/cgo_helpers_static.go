@@ -18,6 +18,7 @@ import (
 	"unsafe"
 )
 
+// #include <stdlib.h>
 import "C"
 
 var cgoAllocsUnknown = new(struct{})
@@ -55,6 +56,16 @@ func convertSlice[T any, I any](input []T) []I {
 	return output
 }
 
+// cArray allocates a zeroed array of n elements in C memory. NVML structs
+// that embed a pointer to a caller-provided array must point into C memory,
+// since cgo does not allow passing Go memory that contains Go pointers.
+// The returned function must be called to release the array.
+func cArray[T any](n int) ([]T, func()) {
+	var t T
+	p := C.calloc(C.size_t(n), C.size_t(unsafe.Sizeof(t)))
+	return unsafe.Slice((*T)(p), n), func() { C.free(p) }
+}
+
 // packPCharString creates a Go string backed by *C.char and avoids copying.
 func packPCharString(p *C.char) (raw string) {
 	if p != nil && *p != 0 {
@@ -0,0 +1,162 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"fmt"
+)
+
+// SetApplicationsClocksChecked sets the applications clocks of a device after
+// validating that the requested memory clock is reported by
+// GetSupportedMemoryClocks and that the requested graphics clock is reported
+// by GetSupportedGraphicsClocks for that memory clock.
+//
+// An error is returned rather than a Return so that a rejected pair can list
+// the supported clocks. Such an error wraps ERROR_INVALID_ARGUMENT, so callers
+// can test for it with errors.Is, and SetApplicationsClocks is not called. Any
+// other failure is returned as the Return itself.
+func SetApplicationsClocksChecked(device Device, memClockMHz uint32, graphicsClockMHz uint32) error {
+	_, memClocks, ret := device.GetSupportedMemoryClocks()
+	if ret != SUCCESS {
+		return ret
+	}
+	if !containsClock(memClocks, memClockMHz) {
+		return fmt.Errorf("memory clock %d MHz is not supported (supported: %v): %w", memClockMHz, memClocks, ERROR_INVALID_ARGUMENT)
+	}
+
+	_, graphicsClocks, ret := device.GetSupportedGraphicsClocks(int(memClockMHz))
+	if ret != SUCCESS {
+		return ret
+	}
+	if !containsClock(graphicsClocks, graphicsClockMHz) {
+		return fmt.Errorf("graphics clock %d MHz is not supported with memory clock %d MHz (supported: %v): %w", graphicsClockMHz, memClockMHz, graphicsClocks, ERROR_INVALID_ARGUMENT)
+	}
+
+	if ret := device.SetApplicationsClocks(memClockMHz, graphicsClockMHz); ret != SUCCESS {
+		return ret
+	}
+	return nil
+}
+
+func containsClock(clocks []uint32, clockMHz uint32) bool {
+	for _, c := range clocks {
+		if c == clockMHz {
+			return true
+		}
+	}
+	return false
+}
+
+// AdaptiveClockingEnabled reports whether adaptive clocking is enabled on a
+// device, as reported by GetAdaptiveClockInfoStatus. Devices that do not
+// support adaptive clocking return ERROR_NOT_SUPPORTED.
+func AdaptiveClockingEnabled(device Device) (bool, Return) {
+	status, ret := device.GetAdaptiveClockInfoStatus()
+	if ret != SUCCESS {
+		return false, ret
+	}
+	return status == ADAPTIVE_CLOCKING_INFO_STATUS_ENABLED, SUCCESS
+}
+
+// ResetClocksToDefault restores the applications clocks and the auto boost
+// setting of a device to their defaults. The applications clocks are reset
+// with ResetApplicationsClocks or, where that is not supported, set to the
+// values reported by GetDefaultApplicationsClock. Auto boost is skipped on
+// devices that do not support it. Calling it repeatedly has no further
+// effect. Both settings require root, so ERROR_NO_PERMISSION is returned when
+// run as another user.
+func ResetClocksToDefault(device Device) Return {
+	ret := device.ResetApplicationsClocks()
+	if isUnsupported(ret) {
+		ret = setDefaultApplicationsClocks(device)
+	}
+	if ret != SUCCESS {
+		return ret
+	}
+
+	_, defaultAutoBoost, ret := device.GetAutoBoostedClocksEnabled()
+	if isUnsupported(ret) {
+		return SUCCESS
+	}
+	if ret != SUCCESS {
+		return ret
+	}
+	return device.SetAutoBoostedClocksEnabled(defaultAutoBoost)
+}
+
+// setDefaultApplicationsClocks sets the applications clocks of a device to
+// their default values.
+func setDefaultApplicationsClocks(device Device) Return {
+	memClockMHz, ret := device.GetDefaultApplicationsClock(CLOCK_MEM)
+	if ret != SUCCESS {
+		return ret
+	}
+	graphicsClockMHz, ret := device.GetDefaultApplicationsClock(CLOCK_GRAPHICS)
+	if ret != SUCCESS {
+		return ret
+	}
+	return device.SetApplicationsClocks(memClockMHz, graphicsClockMHz)
+}
+
+// Suggestions returned by ThrottleAdvisory.
+const (
+	AdviceRaisePowerLimit     = "raise power limit"
+	AdviceImproveCooling      = "improve cooling"
+	AdviceApplicationsClocks  = "clocks capped by app setting"
+	AdviceCheckPowerSupply    = "check power supply and cooling"
+	AdviceCheckPowerBrake     = "check external power brake"
+	AdviceSyncBoost           = "clocks held to sync boost group"
+	AdviceDisplayClockSetting = "clocks capped by display clock setting"
+)
+
+// throttleAdvice maps each clocks event reason bit that limits clocks to a
+// suggested action. ClocksEventReasonGpuIdle is omitted since an idle GPU is
+// not throttled.
+var throttleAdvice = []struct {
+	reason uint64
+	advice string
+}{
+	{ClocksEventReasonSwPowerCap, AdviceRaisePowerLimit},
+	{ClocksEventReasonSwThermalSlowdown, AdviceImproveCooling},
+	{ClocksThrottleReasonHwThermalSlowdown, AdviceImproveCooling},
+	{ClocksEventReasonApplicationsClocksSetting, AdviceApplicationsClocks},
+	{ClocksThrottleReasonHwSlowdown, AdviceCheckPowerSupply},
+	{ClocksThrottleReasonHwPowerBrakeSlowdown, AdviceCheckPowerBrake},
+	{ClocksEventReasonSyncBoost, AdviceSyncBoost},
+	{ClocksEventReasonDisplayClockSetting, AdviceDisplayClockSetting},
+}
+
+// ThrottleAdvisory returns a suggested action for each clocks event reason
+// currently limiting the clocks of a device, without duplicates. A device
+// that is not throttled returns an empty slice.
+func ThrottleAdvisory(device Device) ([]string, Return) {
+	reasons, ret := device.GetCurrentClocksEventReasons()
+	if ret != SUCCESS {
+		return nil, ret
+	}
+
+	advisory := []string{}
+	seen := make(map[string]bool)
+	for _, t := range throttleAdvice {
+		if reasons&t.reason == 0 || seen[t.advice] {
+			continue
+		}
+		seen[t.advice] = true
+		advisory = append(advisory, t.advice)
+	}
+	return advisory, SUCCESS
+}
@@ -0,0 +1,289 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestSetApplicationsClocksChecked(t *testing.T) {
+	supported := map[uint32][]uint32{
+		1215: {1410, 1395, 1380},
+		405:  {405},
+	}
+
+	testCases := []struct {
+		description      string
+		memClockMHz      uint32
+		graphicsClockMHz uint32
+		setRet           nvml.Return
+		expectedSet      bool
+		expectedErr      error
+	}{
+		{
+			description:      "valid pair",
+			memClockMHz:      1215,
+			graphicsClockMHz: 1395,
+			setRet:           nvml.SUCCESS,
+			expectedSet:      true,
+		},
+		{
+			description:      "unsupported memory clock",
+			memClockMHz:      877,
+			graphicsClockMHz: 1395,
+			expectedErr:      nvml.ERROR_INVALID_ARGUMENT,
+		},
+		{
+			description:      "graphics clock unsupported for memory clock",
+			memClockMHz:      405,
+			graphicsClockMHz: 1395,
+			expectedErr:      nvml.ERROR_INVALID_ARGUMENT,
+		},
+		{
+			description:      "setter error is returned",
+			memClockMHz:      1215,
+			graphicsClockMHz: 1410,
+			setRet:           nvml.ERROR_NO_PERMISSION,
+			expectedSet:      true,
+			expectedErr:      nvml.ERROR_NO_PERMISSION,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			device := &mock.Device{
+				GetSupportedMemoryClocksFunc: func() (int, []uint32, nvml.Return) {
+					return 2, []uint32{1215, 405}, nvml.SUCCESS
+				},
+				GetSupportedGraphicsClocksFunc: func(memoryClockMHz int) (int, []uint32, nvml.Return) {
+					clocks := supported[uint32(memoryClockMHz)]
+					return len(clocks), clocks, nvml.SUCCESS
+				},
+				SetApplicationsClocksFunc: func(memClockMHz uint32, graphicsClockMHz uint32) nvml.Return {
+					return tc.setRet
+				},
+			}
+
+			err := nvml.SetApplicationsClocksChecked(device, tc.memClockMHz, tc.graphicsClockMHz)
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.True(t, errors.Is(err, tc.expectedErr), "unexpected error: %v", err)
+				var ret nvml.Return
+				require.True(t, errors.As(err, &ret))
+				require.Equal(t, tc.expectedErr, ret)
+			}
+			if tc.expectedErr == nvml.ERROR_INVALID_ARGUMENT {
+				require.Contains(t, err.Error(), "supported: ")
+			}
+
+			calls := device.SetApplicationsClocksCalls()
+			if !tc.expectedSet {
+				require.Empty(t, calls)
+				return
+			}
+			require.Len(t, calls, 1)
+			require.Equal(t, tc.memClockMHz, calls[0].V1)
+			require.Equal(t, tc.graphicsClockMHz, calls[0].V2)
+		})
+	}
+}
+
+func TestAdaptiveClockingEnabled(t *testing.T) {
+	testCases := []struct {
+		description     string
+		status          uint32
+		ret             nvml.Return
+		expectedEnabled bool
+		expectedRet     nvml.Return
+	}{
+		{"enabled", nvml.ADAPTIVE_CLOCKING_INFO_STATUS_ENABLED, nvml.SUCCESS, true, nvml.SUCCESS},
+		{"disabled", nvml.ADAPTIVE_CLOCKING_INFO_STATUS_DISABLED, nvml.SUCCESS, false, nvml.SUCCESS},
+		{"not supported", 0, nvml.ERROR_NOT_SUPPORTED, false, nvml.ERROR_NOT_SUPPORTED},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			device := &mock.Device{
+				GetAdaptiveClockInfoStatusFunc: func() (uint32, nvml.Return) {
+					return tc.status, tc.ret
+				},
+			}
+
+			enabled, ret := nvml.AdaptiveClockingEnabled(device)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expectedEnabled, enabled)
+		})
+	}
+}
+
+func TestResetClocksToDefault(t *testing.T) {
+	testCases := []struct {
+		description       string
+		resetRet          nvml.Return
+		setRet            nvml.Return
+		autoBoostRet      nvml.Return
+		expectedRet       nvml.Return
+		expectedSet       bool
+		expectedAutoBoost bool
+	}{
+		{
+			description:       "reset supported",
+			expectedRet:       nvml.SUCCESS,
+			expectedAutoBoost: true,
+		},
+		{
+			description:       "reset not supported",
+			resetRet:          nvml.ERROR_NOT_SUPPORTED,
+			expectedRet:       nvml.SUCCESS,
+			expectedSet:       true,
+			expectedAutoBoost: true,
+		},
+		{
+			description:  "auto boost not supported",
+			autoBoostRet: nvml.ERROR_NOT_SUPPORTED,
+			expectedRet:  nvml.SUCCESS,
+		},
+		{
+			description: "no permission",
+			resetRet:    nvml.ERROR_NO_PERMISSION,
+			expectedRet: nvml.ERROR_NO_PERMISSION,
+		},
+		{
+			description: "no permission to set defaults",
+			resetRet:    nvml.ERROR_NOT_SUPPORTED,
+			setRet:      nvml.ERROR_NO_PERMISSION,
+			expectedRet: nvml.ERROR_NO_PERMISSION,
+			expectedSet: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			device := &mock.Device{
+				ResetApplicationsClocksFunc: func() nvml.Return {
+					return tc.resetRet
+				},
+				GetDefaultApplicationsClockFunc: func(clockType nvml.ClockType) (uint32, nvml.Return) {
+					switch clockType {
+					case nvml.CLOCK_MEM:
+						return 1593, nvml.SUCCESS
+					case nvml.CLOCK_GRAPHICS:
+						return 1410, nvml.SUCCESS
+					}
+					return 0, nvml.ERROR_INVALID_ARGUMENT
+				},
+				SetApplicationsClocksFunc: func(memClockMHz uint32, graphicsClockMHz uint32) nvml.Return {
+					return tc.setRet
+				},
+				GetAutoBoostedClocksEnabledFunc: func() (nvml.EnableState, nvml.EnableState, nvml.Return) {
+					return nvml.FEATURE_DISABLED, nvml.FEATURE_ENABLED, tc.autoBoostRet
+				},
+				SetAutoBoostedClocksEnabledFunc: func(enabled nvml.EnableState) nvml.Return {
+					return nvml.SUCCESS
+				},
+			}
+
+			ret := nvml.ResetClocksToDefault(device)
+			require.Equal(t, tc.expectedRet, ret)
+
+			setCalls := device.SetApplicationsClocksCalls()
+			if tc.expectedSet {
+				require.Len(t, setCalls, 1)
+				require.Equal(t, uint32(1593), setCalls[0].V1)
+				require.Equal(t, uint32(1410), setCalls[0].V2)
+			} else {
+				require.Empty(t, setCalls)
+			}
+
+			autoBoostCalls := device.SetAutoBoostedClocksEnabledCalls()
+			if tc.expectedAutoBoost {
+				require.Len(t, autoBoostCalls, 1)
+				require.Equal(t, nvml.FEATURE_ENABLED, autoBoostCalls[0].EnableState)
+			} else {
+				require.Empty(t, autoBoostCalls)
+			}
+		})
+	}
+}
+
+func TestThrottleAdvisory(t *testing.T) {
+	testCases := []struct {
+		description      string
+		reasons          uint64
+		ret              nvml.Return
+		expectedAdvisory []string
+		expectedRet      nvml.Return
+	}{
+		{
+			description:      "not throttled",
+			reasons:          nvml.ClocksEventReasonNone,
+			expectedAdvisory: []string{},
+			expectedRet:      nvml.SUCCESS,
+		},
+		{
+			description:      "idle",
+			reasons:          nvml.ClocksEventReasonGpuIdle,
+			expectedAdvisory: []string{},
+			expectedRet:      nvml.SUCCESS,
+		},
+		{
+			description:      "hardware thermal slowdown",
+			reasons:          nvml.ClocksThrottleReasonHwThermalSlowdown,
+			expectedAdvisory: []string{nvml.AdviceImproveCooling},
+			expectedRet:      nvml.SUCCESS,
+		},
+		{
+			description: "power capped and thermal slowdown",
+			reasons: nvml.ClocksEventReasonSwPowerCap |
+				nvml.ClocksEventReasonSwThermalSlowdown |
+				nvml.ClocksThrottleReasonHwThermalSlowdown |
+				nvml.ClocksEventReasonApplicationsClocksSetting,
+			expectedAdvisory: []string{
+				nvml.AdviceRaisePowerLimit,
+				nvml.AdviceImproveCooling,
+				nvml.AdviceApplicationsClocks,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "not supported",
+			ret:         nvml.ERROR_NOT_SUPPORTED,
+			expectedRet: nvml.ERROR_NOT_SUPPORTED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			device := &mock.Device{
+				GetCurrentClocksEventReasonsFunc: func() (uint64, nvml.Return) {
+					return tc.reasons, tc.ret
+				},
+			}
+
+			advisory, ret := nvml.ThrottleAdvisory(device)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expectedAdvisory, advisory)
+		})
+	}
+}
@@ -25,3 +25,40 @@ const (
 func STRUCT_VERSION(data interface{}, version uint32) uint32 {
 	return uint32(uint32(reflect.Indirect(reflect.ValueOf(data)).Type().Size()) | (version << uint32(24)))
 }
+
+// Bool reports whether an EnableState is FEATURE_ENABLED.
+func (s EnableState) Bool() bool {
+	return s == FEATURE_ENABLED
+}
+
+// BoolToEnableState converts a bool to FEATURE_ENABLED or FEATURE_DISABLED.
+func BoolToEnableState(enabled bool) EnableState {
+	if enabled {
+		return FEATURE_ENABLED
+	}
+	return FEATURE_DISABLED
+}
+
+// ConfComputeCpuCaps holds one of the CC_SYSTEM_CPU_CAPS_* values reported in
+// ConfComputeSystemCaps.CpuCaps.
+type ConfComputeCpuCaps uint32
+
+// ConfComputeGpusCaps holds one of the CC_SYSTEM_GPUS_CC_* values reported in
+// ConfComputeSystemCaps.GpusCaps.
+type ConfComputeGpusCaps uint32
+
+// CpuCapabilities returns the CpuCaps field of the capabilities as a
+// ConfComputeCpuCaps.
+func (c ConfComputeSystemCaps) CpuCapabilities() ConfComputeCpuCaps {
+	return ConfComputeCpuCaps(c.CpuCaps)
+}
+
+// GpusCapabilities returns the GpusCaps field of the capabilities as a
+// ConfComputeGpusCaps.
+func (c ConfComputeSystemCaps) GpusCapabilities() ConfComputeGpusCaps {
+	return ConfComputeGpusCaps(c.GpusCaps)
+}
+
+// EventType holds one of the EventType* values reported in
+// EventData.EventType.
+type EventType uint64
@@ -0,0 +1,42 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnableStateBool(t *testing.T) {
+	testCases := []struct {
+		state   EnableState
+		enabled bool
+	}{
+		{FEATURE_DISABLED, false},
+		{FEATURE_ENABLED, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.state.String(), func(t *testing.T) {
+			require.Equal(t, tc.enabled, tc.state.Bool())
+			require.Equal(t, tc.state, BoolToEnableState(tc.enabled))
+		})
+	}
+
+	require.False(t, EnableState(2).Bool())
+}
@@ -17,6 +17,7 @@ package nvml
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"unsafe"
 )
 
@@ -136,10 +137,13 @@ func (l *library) DeviceGetHandleByIndex(index int) (Device, Return) {
 // nvml.DeviceGetHandleBySerial()
 func (l *library) DeviceGetHandleBySerial(serial string) (Device, Return) {
 	var device nvmlDevice
-	ret := nvmlDeviceGetHandleBySerial(serial+string(rune(0)), &device)
+	ret := nvmlDeviceGetHandleBySerialStub(serial+string(rune(0)), &device)
 	return device, ret
 }
 
+// nvmlDeviceGetHandleBySerialStub allows us to override this for testing.
+var nvmlDeviceGetHandleBySerialStub = nvmlDeviceGetHandleBySerial
+
 // nvml.DeviceGetHandleByUUID()
 func (l *library) DeviceGetHandleByUUID(uuid string) (Device, Return) {
 	var device nvmlDevice
@@ -159,10 +163,15 @@ func (l *library) DeviceGetName(device Device) (string, Return) {
 	return device.GetName()
 }
 
+// nvmlDeviceGetNameStub allows us to override this for testing.
+var nvmlDeviceGetNameStub = nvmlDeviceGetName
+
+// GetName returns the product name of the device. Some drivers pad the name
+// with trailing whitespace, which is trimmed so that names can be compared.
 func (device nvmlDevice) GetName() (string, Return) {
 	name := make([]byte, DEVICE_NAME_V2_BUFFER_SIZE)
-	ret := nvmlDeviceGetName(device, &name[0], DEVICE_NAME_V2_BUFFER_SIZE)
-	return string(name[:clen(name)]), ret
+	ret := nvmlDeviceGetNameStub(device, &name[0], DEVICE_NAME_V2_BUFFER_SIZE)
+	return strings.TrimRight(string(name[:clen(name)]), " \t\r\n"), ret
 }
 
 // nvml.DeviceGetBrand()
@@ -194,10 +203,13 @@ func (l *library) DeviceGetSerial(device Device) (string, Return) {
 
 func (device nvmlDevice) GetSerial() (string, Return) {
 	serial := make([]byte, DEVICE_SERIAL_BUFFER_SIZE)
-	ret := nvmlDeviceGetSerial(device, &serial[0], DEVICE_SERIAL_BUFFER_SIZE)
+	ret := nvmlDeviceGetSerialStub(device, &serial[0], DEVICE_SERIAL_BUFFER_SIZE)
 	return string(serial[:clen(serial)]), ret
 }
 
+// nvmlDeviceGetSerialStub allows us to override this for testing.
+var nvmlDeviceGetSerialStub = nvmlDeviceGetSerial
+
 // nvml.DeviceGetCpuAffinity()
 func (l *library) DeviceGetCpuAffinity(device Device, numCPUs int) ([]uint, Return) {
 	return device.GetCpuAffinity(numCPUs)
@@ -372,27 +384,40 @@ func (device nvmlDevice) ValidateInforom() Return {
 }
 
 // nvml.DeviceGetDisplayMode()
+//
+// The display mode reports whether a physical display is connected to any of
+// the device's outputs. Use Bool on the result for a plain bool.
 func (l *library) DeviceGetDisplayMode(device Device) (EnableState, Return) {
 	return device.GetDisplayMode()
 }
 
 func (device nvmlDevice) GetDisplayMode() (EnableState, Return) {
 	var display EnableState
-	ret := nvmlDeviceGetDisplayMode(device, &display)
+	ret := nvmlDeviceGetDisplayModeStub(device, &display)
 	return display, ret
 }
 
+// nvmlDeviceGetDisplayModeStub allows us to override this for testing.
+var nvmlDeviceGetDisplayModeStub = nvmlDeviceGetDisplayMode
+
 // nvml.DeviceGetDisplayActive()
+//
+// A display is active if memory is allocated on the device for one, even if
+// no physical display is connected, e.g. when an X server runs on the device.
+// Use Bool on the result for a plain bool.
 func (l *library) DeviceGetDisplayActive(device Device) (EnableState, Return) {
 	return device.GetDisplayActive()
 }
 
 func (device nvmlDevice) GetDisplayActive() (EnableState, Return) {
 	var isActive EnableState
-	ret := nvmlDeviceGetDisplayActive(device, &isActive)
+	ret := nvmlDeviceGetDisplayActiveStub(device, &isActive)
 	return isActive, ret
 }
 
+// nvmlDeviceGetDisplayActiveStub allows us to override this for testing.
+var nvmlDeviceGetDisplayActiveStub = nvmlDeviceGetDisplayActive
+
 // nvml.DeviceGetPersistenceMode()
 func (l *library) DeviceGetPersistenceMode(device Device) (EnableState, Return) {
 	return device.GetPersistenceMode()
@@ -539,9 +564,15 @@ func (l *library) DeviceGetClock(device Device, clockType ClockType, clockId Clo
 	return device.GetClock(clockType, clockId)
 }
 
+// nvmlDeviceGetClockStub allows us to override this for testing.
+var nvmlDeviceGetClockStub = nvmlDeviceGetClock
+
+// GetClock returns the clock of the given type in MHz, as selected by the
+// clockId, e.g. CLOCK_ID_CUSTOMER_BOOST_MAX. Combinations that the device
+// does not support return ERROR_NOT_SUPPORTED.
 func (device nvmlDevice) GetClock(clockType ClockType, clockId ClockId) (uint32, Return) {
 	var clockMHz uint32
-	ret := nvmlDeviceGetClock(device, clockType, clockId, &clockMHz)
+	ret := nvmlDeviceGetClockStub(device, clockType, clockId, &clockMHz)
 	return clockMHz, ret
 }
 
@@ -557,27 +588,55 @@ func (device nvmlDevice) GetMaxCustomerBoostClock(clockType ClockType) (uint32,
 }
 
 // nvml.DeviceGetSupportedMemoryClocks()
-func (l *library) DeviceGetSupportedMemoryClocks(device Device) (int, uint32, Return) {
+func (l *library) DeviceGetSupportedMemoryClocks(device Device) (int, []uint32, Return) {
 	return device.GetSupportedMemoryClocks()
 }
 
-func (device nvmlDevice) GetSupportedMemoryClocks() (int, uint32, Return) {
-	var count, clocksMHz uint32
-	ret := nvmlDeviceGetSupportedMemoryClocks(device, &count, &clocksMHz)
-	return int(count), clocksMHz, ret
+func (device nvmlDevice) GetSupportedMemoryClocks() (int, []uint32, Return) {
+	var count uint32
+	ret := nvmlDeviceGetSupportedMemoryClocksStub(device, &count, nil)
+	if ret == SUCCESS {
+		return 0, []uint32{}, ret
+	}
+	if ret != ERROR_INSUFFICIENT_SIZE || count == 0 {
+		return 0, nil, ret
+	}
+	clocksMHz := make([]uint32, count)
+	ret = nvmlDeviceGetSupportedMemoryClocksStub(device, &count, &clocksMHz[0])
+	if ret != SUCCESS {
+		return 0, nil, ret
+	}
+	return int(count), clocksMHz[:count], ret
 }
 
+// nvmlDeviceGetSupportedMemoryClocksStub allows us to override this for testing.
+var nvmlDeviceGetSupportedMemoryClocksStub = nvmlDeviceGetSupportedMemoryClocks
+
 // nvml.DeviceGetSupportedGraphicsClocks()
-func (l *library) DeviceGetSupportedGraphicsClocks(device Device, memoryClockMHz int) (int, uint32, Return) {
+func (l *library) DeviceGetSupportedGraphicsClocks(device Device, memoryClockMHz int) (int, []uint32, Return) {
 	return device.GetSupportedGraphicsClocks(memoryClockMHz)
 }
 
-func (device nvmlDevice) GetSupportedGraphicsClocks(memoryClockMHz int) (int, uint32, Return) {
-	var count, clocksMHz uint32
-	ret := nvmlDeviceGetSupportedGraphicsClocks(device, uint32(memoryClockMHz), &count, &clocksMHz)
-	return int(count), clocksMHz, ret
+func (device nvmlDevice) GetSupportedGraphicsClocks(memoryClockMHz int) (int, []uint32, Return) {
+	var count uint32
+	ret := nvmlDeviceGetSupportedGraphicsClocksStub(device, uint32(memoryClockMHz), &count, nil)
+	if ret == SUCCESS {
+		return 0, []uint32{}, ret
+	}
+	if ret != ERROR_INSUFFICIENT_SIZE || count == 0 {
+		return 0, nil, ret
+	}
+	clocksMHz := make([]uint32, count)
+	ret = nvmlDeviceGetSupportedGraphicsClocksStub(device, uint32(memoryClockMHz), &count, &clocksMHz[0])
+	if ret != SUCCESS {
+		return 0, nil, ret
+	}
+	return int(count), clocksMHz[:count], ret
 }
 
+// nvmlDeviceGetSupportedGraphicsClocksStub allows us to override this for testing.
+var nvmlDeviceGetSupportedGraphicsClocksStub = nvmlDeviceGetSupportedGraphicsClocks
+
 // nvml.DeviceGetAutoBoostedClocksEnabled()
 func (l *library) DeviceGetAutoBoostedClocksEnabled(device Device) (EnableState, EnableState, Return) {
 	return device.GetAutoBoostedClocksEnabled()
@@ -663,13 +722,20 @@ func (device nvmlDevice) GetTemperatureThreshold(thresholdType TemperatureThresh
 }
 
 // nvml.DeviceSetTemperatureThreshold()
+//
+// Only some thresholds, such as TEMPERATURE_THRESHOLD_ACOUSTIC_CURR, can be
+// set; read-only thresholds return ERROR_NOT_SUPPORTED. Setting a threshold
+// requires root and returns ERROR_NO_PERMISSION otherwise.
 func (l *library) DeviceSetTemperatureThreshold(device Device, thresholdType TemperatureThresholds, temp int) Return {
 	return device.SetTemperatureThreshold(thresholdType, temp)
 }
 
+// nvmlDeviceSetTemperatureThresholdStub allows us to override this for testing.
+var nvmlDeviceSetTemperatureThresholdStub = nvmlDeviceSetTemperatureThreshold
+
 func (device nvmlDevice) SetTemperatureThreshold(thresholdType TemperatureThresholds, temp int) Return {
 	t := int32(temp)
-	ret := nvmlDeviceSetTemperatureThreshold(device, thresholdType, &t)
+	ret := nvmlDeviceSetTemperatureThresholdStub(device, thresholdType, &t)
 	return ret
 }
 
@@ -680,10 +746,13 @@ func (l *library) DeviceGetPerformanceState(device Device) (Pstates, Return) {
 
 func (device nvmlDevice) GetPerformanceState() (Pstates, Return) {
 	var pState Pstates
-	ret := nvmlDeviceGetPerformanceState(device, &pState)
+	ret := nvmlDeviceGetPerformanceStateStub(device, &pState)
 	return pState, ret
 }
 
+// nvmlDeviceGetPerformanceStateStub allows us to override this for testing.
+var nvmlDeviceGetPerformanceStateStub = nvmlDeviceGetPerformanceState
+
 // nvml.DeviceGetCurrentClocksThrottleReasons()
 func (l *library) DeviceGetCurrentClocksThrottleReasons(device Device) (uint64, Return) {
 	return device.GetCurrentClocksThrottleReasons()
@@ -691,10 +760,16 @@ func (l *library) DeviceGetCurrentClocksThrottleReasons(device Device) (uint64,
 
 func (device nvmlDevice) GetCurrentClocksThrottleReasons() (uint64, Return) {
 	var clocksThrottleReasons uint64
-	ret := nvmlDeviceGetCurrentClocksThrottleReasons(device, &clocksThrottleReasons)
+	ret := deviceGetCurrentClocksEventReasons(device, &clocksThrottleReasons)
+	if ret == ERROR_FUNCTION_NOT_FOUND {
+		ret = nvmlDeviceGetCurrentClocksThrottleReasonsStub(device, &clocksThrottleReasons)
+	}
 	return clocksThrottleReasons, ret
 }
 
+// nvmlDeviceGetCurrentClocksThrottleReasonsStub allows us to override this for testing.
+var nvmlDeviceGetCurrentClocksThrottleReasonsStub = nvmlDeviceGetCurrentClocksThrottleReasons
+
 // nvml.DeviceGetSupportedClocksThrottleReasons()
 func (l *library) DeviceGetSupportedClocksThrottleReasons(device Device) (uint64, Return) {
 	return device.GetSupportedClocksThrottleReasons()
@@ -702,19 +777,26 @@ func (l *library) DeviceGetSupportedClocksThrottleReasons(device Device) (uint64
 
 func (device nvmlDevice) GetSupportedClocksThrottleReasons() (uint64, Return) {
 	var supportedClocksThrottleReasons uint64
-	ret := nvmlDeviceGetSupportedClocksThrottleReasons(device, &supportedClocksThrottleReasons)
+	ret := deviceGetSupportedClocksEventReasons(device, &supportedClocksThrottleReasons)
+	if ret == ERROR_FUNCTION_NOT_FOUND {
+		ret = nvmlDeviceGetSupportedClocksThrottleReasonsStub(device, &supportedClocksThrottleReasons)
+	}
 	return supportedClocksThrottleReasons, ret
 }
 
+// nvmlDeviceGetSupportedClocksThrottleReasonsStub allows us to override this for testing.
+var nvmlDeviceGetSupportedClocksThrottleReasonsStub = nvmlDeviceGetSupportedClocksThrottleReasons
+
 // nvml.DeviceGetPowerState()
+//
+// Deprecated: GetPowerState is a legacy alias of GetPerformanceState, which
+// should be used instead.
 func (l *library) DeviceGetPowerState(device Device) (Pstates, Return) {
 	return device.GetPowerState()
 }
 
 func (device nvmlDevice) GetPowerState() (Pstates, Return) {
-	var pState Pstates
-	ret := nvmlDeviceGetPowerState(device, &pState)
-	return pState, ret
+	return device.GetPerformanceState()
 }
 
 // nvml.DeviceGetPowerManagementMode()
@@ -795,13 +877,20 @@ func (device nvmlDevice) GetEnforcedPowerLimit() (uint32, Return) {
 }
 
 // nvml.DeviceGetGpuOperationMode()
+//
+// GPU Operation Modes are only supported by some older Tesla boards; other
+// devices return ERROR_NOT_SUPPORTED. A pending mode takes effect after the
+// next reboot.
 func (l *library) DeviceGetGpuOperationMode(device Device) (GpuOperationMode, GpuOperationMode, Return) {
 	return device.GetGpuOperationMode()
 }
 
+// nvmlDeviceGetGpuOperationModeStub allows us to override this for testing.
+var nvmlDeviceGetGpuOperationModeStub = nvmlDeviceGetGpuOperationMode
+
 func (device nvmlDevice) GetGpuOperationMode() (GpuOperationMode, GpuOperationMode, Return) {
 	var current, pending GpuOperationMode
-	ret := nvmlDeviceGetGpuOperationMode(device, &current, &pending)
+	ret := nvmlDeviceGetGpuOperationModeStub(device, &current, &pending)
 	return current, pending, ret
 }
 
@@ -824,7 +913,7 @@ func (l *library) DeviceGetMemoryInfo_v2(device Device) (Memory_v2, Return) {
 func (device nvmlDevice) GetMemoryInfo_v2() (Memory_v2, Return) {
 	var memory Memory_v2
 	memory.Version = STRUCT_VERSION(memory, 2)
-	ret := nvmlDeviceGetMemoryInfo_v2(device, &memory)
+	ret := deviceGetMemoryInfo_v2(device, &memory)
 	return memory, ret
 }
 
@@ -857,10 +946,13 @@ func (l *library) DeviceGetEccMode(device Device) (EnableState, EnableState, Ret
 
 func (device nvmlDevice) GetEccMode() (EnableState, EnableState, Return) {
 	var current, pending EnableState
-	ret := nvmlDeviceGetEccMode(device, &current, &pending)
+	ret := nvmlDeviceGetEccModeStub(device, &current, &pending)
 	return current, pending, ret
 }
 
+// nvmlDeviceGetEccModeStub allows us to override this for testing.
+var nvmlDeviceGetEccModeStub = nvmlDeviceGetEccMode
+
 // nvml.DeviceGetBoardId()
 func (l *library) DeviceGetBoardId(device Device) (uint32, Return) {
 	return device.GetBoardId()
@@ -1023,16 +1115,23 @@ func (device nvmlDevice) GetFBCSessions() ([]FBCSessionInfo, Return) {
 }
 
 // nvml.DeviceGetDriverModel()
+//
+// The driver model (WDDM or WDM, also known as TCC) only applies on Windows;
+// on other platforms ERROR_NOT_SUPPORTED is returned. The pending driver model
+// takes effect after a reboot.
 func (l *library) DeviceGetDriverModel(device Device) (DriverModel, DriverModel, Return) {
 	return device.GetDriverModel()
 }
 
 func (device nvmlDevice) GetDriverModel() (DriverModel, DriverModel, Return) {
 	var current, pending DriverModel
-	ret := nvmlDeviceGetDriverModel(device, &current, &pending)
+	ret := nvmlDeviceGetDriverModelStub(device, &current, &pending)
 	return current, pending, ret
 }
 
+// nvmlDeviceGetDriverModelStub allows us to override this for testing.
+var nvmlDeviceGetDriverModelStub = nvmlDeviceGetDriverModel
+
 // nvml.DeviceGetVbiosVersion()
 func (l *library) DeviceGetVbiosVersion(device Device) (string, Return) {
 	return device.GetVbiosVersion()
@@ -1315,13 +1414,18 @@ func (device nvmlDevice) GetPowerSource() (PowerSource, Return) {
 }
 
 // nvml.DeviceGetMemoryBusWidth()
+//
+// The width is reported in bits, e.g. 5120 for HBM3 on an H100.
 func (l *library) DeviceGetMemoryBusWidth(device Device) (uint32, Return) {
 	return device.GetMemoryBusWidth()
 }
 
+// nvmlDeviceGetMemoryBusWidthStub allows us to override this for testing.
+var nvmlDeviceGetMemoryBusWidthStub = nvmlDeviceGetMemoryBusWidth
+
 func (device nvmlDevice) GetMemoryBusWidth() (uint32, Return) {
 	var busWidth uint32
-	ret := nvmlDeviceGetMemoryBusWidth(device, &busWidth)
+	ret := nvmlDeviceGetMemoryBusWidthStub(device, &busWidth)
 	return busWidth, ret
 }
 
@@ -1471,6 +1575,10 @@ func (device nvmlDevice) SetComputeMode(mode ComputeMode) Return {
 }
 
 // nvml.DeviceSetEccMode()
+//
+// Changing the ECC mode requires root and only takes effect after the next
+// reboot or GPU reset; until then GetEccMode reports the requested mode as
+// pending while the current mode is unchanged.
 func (l *library) DeviceSetEccMode(device Device, ecc EnableState) Return {
 	return device.SetEccMode(ecc)
 }
@@ -1485,18 +1593,29 @@ func (l *library) DeviceClearEccErrorCounts(device Device, counterType EccCounte
 }
 
 func (device nvmlDevice) ClearEccErrorCounts(counterType EccCounterType) Return {
-	return nvmlDeviceClearEccErrorCounts(device, counterType)
+	return nvmlDeviceClearEccErrorCountsStub(device, counterType)
 }
 
+// nvmlDeviceClearEccErrorCountsStub allows us to override this for testing.
+var nvmlDeviceClearEccErrorCountsStub = nvmlDeviceClearEccErrorCounts
+
 // nvml.DeviceSetDriverModel()
+//
+// Changing the driver model requires administrator privileges and only takes
+// effect after a reboot. Like GetDriverModel, it is only supported on
+// Windows. By default NVML refuses to switch a device that drives a display to
+// TCC; setting bit 0 of flags forces the change.
 func (l *library) DeviceSetDriverModel(device Device, driverModel DriverModel, flags uint32) Return {
 	return device.SetDriverModel(driverModel, flags)
 }
 
 func (device nvmlDevice) SetDriverModel(driverModel DriverModel, flags uint32) Return {
-	return nvmlDeviceSetDriverModel(device, driverModel, flags)
+	return nvmlDeviceSetDriverModelStub(device, driverModel, flags)
 }
 
+// nvmlDeviceSetDriverModelStub allows us to override this for testing.
+var nvmlDeviceSetDriverModelStub = nvmlDeviceSetDriverModel
+
 // nvml.DeviceSetGpuLockedClocks()
 func (l *library) DeviceSetGpuLockedClocks(device Device, minGpuClockMHz uint32, maxGpuClockMHz uint32) Return {
 	return device.SetGpuLockedClocks(minGpuClockMHz, maxGpuClockMHz)
@@ -1567,8 +1686,11 @@ func (l *library) DeviceSetGpuOperationMode(device Device, mode GpuOperationMode
 	return device.SetGpuOperationMode(mode)
 }
 
+// nvmlDeviceSetGpuOperationModeStub allows us to override this for testing.
+var nvmlDeviceSetGpuOperationModeStub = nvmlDeviceSetGpuOperationMode
+
 func (device nvmlDevice) SetGpuOperationMode(mode GpuOperationMode) Return {
-	return nvmlDeviceSetGpuOperationMode(device, mode)
+	return nvmlDeviceSetGpuOperationModeStub(device, mode)
 }
 
 // nvml.DeviceSetAPIRestriction()
@@ -1605,10 +1727,13 @@ func (l *library) DeviceGetNvLinkState(device Device, link int) (EnableState, Re
 
 func (device nvmlDevice) GetNvLinkState(link int) (EnableState, Return) {
 	var isActive EnableState
-	ret := nvmlDeviceGetNvLinkState(device, uint32(link), &isActive)
+	ret := nvmlDeviceGetNvLinkStateStub(device, uint32(link), &isActive)
 	return isActive, ret
 }
 
+// nvmlDeviceGetNvLinkStateStub allows us to override this for testing.
+var nvmlDeviceGetNvLinkStateStub = nvmlDeviceGetNvLinkState
+
 // nvml.DeviceGetNvLinkVersion()
 func (l *library) DeviceGetNvLinkVersion(device Device, link int) (uint32, Return) {
 	return device.GetNvLinkVersion(link)
@@ -1616,10 +1741,13 @@ func (l *library) DeviceGetNvLinkVersion(device Device, link int) (uint32, Retur
 
 func (device nvmlDevice) GetNvLinkVersion(link int) (uint32, Return) {
 	var version uint32
-	ret := nvmlDeviceGetNvLinkVersion(device, uint32(link), &version)
+	ret := nvmlDeviceGetNvLinkVersionStub(device, uint32(link), &version)
 	return version, ret
 }
 
+// nvmlDeviceGetNvLinkVersionStub allows us to override this for testing.
+var nvmlDeviceGetNvLinkVersionStub = nvmlDeviceGetNvLinkVersion
+
 // nvml.DeviceGetNvLinkCapability()
 func (l *library) DeviceGetNvLinkCapability(device Device, link int, capability NvLinkCapability) (uint32, Return) {
 	return device.GetNvLinkCapability(link, capability)
@@ -1649,10 +1777,13 @@ func (l *library) DeviceGetNvLinkErrorCounter(device Device, link int, counter N
 
 func (device nvmlDevice) GetNvLinkErrorCounter(link int, counter NvLinkErrorCounter) (uint64, Return) {
 	var counterValue uint64
-	ret := nvmlDeviceGetNvLinkErrorCounter(device, uint32(link), counter, &counterValue)
+	ret := nvmlDeviceGetNvLinkErrorCounterStub(device, uint32(link), counter, &counterValue)
 	return counterValue, ret
 }
 
+// nvmlDeviceGetNvLinkErrorCounterStub allows us to override this for testing.
+var nvmlDeviceGetNvLinkErrorCounterStub = nvmlDeviceGetNvLinkErrorCounter
+
 // nvml.DeviceResetNvLinkErrorCounters()
 func (l *library) DeviceResetNvLinkErrorCounters(device Device, link int) Return {
 	return device.ResetNvLinkErrorCounters(link)
@@ -1667,12 +1798,20 @@ func (l *library) DeviceSetNvLinkUtilizationControl(device Device, link int, cou
 	return device.SetNvLinkUtilizationControl(link, counter, control, reset)
 }
 
+// nvmlDeviceSetNvLinkUtilizationControlStub allows us to override this for testing.
+var nvmlDeviceSetNvLinkUtilizationControlStub = nvmlDeviceSetNvLinkUtilizationControl
+
+// SetNvLinkUtilizationControl configures the units and packet filter of the
+// given utilization counter on a link. The control is typically built from a
+// NvLinkUtilizationCountUnits and a NvLinkUtilizationCountPktTypes value. If
+// reset is true, the counter is also reset. Links that are not active return
+// ERROR_NOT_SUPPORTED.
 func (device nvmlDevice) SetNvLinkUtilizationControl(link int, counter int, control *NvLinkUtilizationControl, reset bool) Return {
 	resetValue := uint32(0)
 	if reset {
 		resetValue = 1
 	}
-	return nvmlDeviceSetNvLinkUtilizationControl(device, uint32(link), uint32(counter), control, resetValue)
+	return nvmlDeviceSetNvLinkUtilizationControlStub(device, uint32(link), uint32(counter), control, resetValue)
 }
 
 // nvml.DeviceGetNvLinkUtilizationControl()
@@ -1680,9 +1819,15 @@ func (l *library) DeviceGetNvLinkUtilizationControl(device Device, link int, cou
 	return device.GetNvLinkUtilizationControl(link, counter)
 }
 
+// nvmlDeviceGetNvLinkUtilizationControlStub allows us to override this for testing.
+var nvmlDeviceGetNvLinkUtilizationControlStub = nvmlDeviceGetNvLinkUtilizationControl
+
+// GetNvLinkUtilizationControl returns the units and packet filter that the
+// given utilization counter on a link is configured with. Links that are not
+// active return ERROR_NOT_SUPPORTED.
 func (device nvmlDevice) GetNvLinkUtilizationControl(link int, counter int) (NvLinkUtilizationControl, Return) {
 	var control NvLinkUtilizationControl
-	ret := nvmlDeviceGetNvLinkUtilizationControl(device, uint32(link), uint32(counter), &control)
+	ret := nvmlDeviceGetNvLinkUtilizationControlStub(device, uint32(link), uint32(counter), &control)
 	return control, ret
 }
 
@@ -1720,9 +1865,15 @@ func (l *library) DeviceGetNvLinkRemoteDeviceType(device Device, link int) (IntN
 	return device.GetNvLinkRemoteDeviceType(link)
 }
 
+// nvmlDeviceGetNvLinkRemoteDeviceTypeStub allows us to override this for testing.
+var nvmlDeviceGetNvLinkRemoteDeviceTypeStub = nvmlDeviceGetNvLinkRemoteDeviceType
+
+// GetNvLinkRemoteDeviceType returns the type of the device at the far end of
+// a link, i.e. a GPU, an NVSwitch or an IBM NPU. Links that are not active
+// return ERROR_NOT_SUPPORTED.
 func (device nvmlDevice) GetNvLinkRemoteDeviceType(link int) (IntNvLinkDeviceType, Return) {
-	var nvLinkDeviceType IntNvLinkDeviceType
-	ret := nvmlDeviceGetNvLinkRemoteDeviceType(device, uint32(link), &nvLinkDeviceType)
+	nvLinkDeviceType := NVLINK_DEVICE_TYPE_UNKNOWN
+	ret := nvmlDeviceGetNvLinkRemoteDeviceTypeStub(device, uint32(link), &nvLinkDeviceType)
 	return nvLinkDeviceType, ret
 }
 
@@ -1782,7 +1933,10 @@ func (l *library) DeviceGetFieldValues(device Device, values []FieldValue) Retur
 
 func (device nvmlDevice) GetFieldValues(values []FieldValue) Return {
 	valuesCount := len(values)
-	return nvmlDeviceGetFieldValues(device, int32(valuesCount), &values[0])
+	if valuesCount == 0 {
+		return SUCCESS
+	}
+	return deviceGetFieldValues(device, int32(valuesCount), &values[0])
 }
 
 // nvml.DeviceGetVirtualizationMode()
@@ -1895,7 +2049,7 @@ func (device nvmlDevice) GetActiveVgpus() ([]VgpuInstance, Return) {
 	var vgpuCount uint32 = 1 // Will be reduced upon returning
 	for {
 		vgpuInstances := make([]nvmlVgpuInstance, vgpuCount)
-		ret := nvmlDeviceGetActiveVgpus(device, &vgpuCount, &vgpuInstances[0])
+		ret := nvmlDeviceGetActiveVgpusStub(device, &vgpuCount, &vgpuInstances[0])
 		if ret == SUCCESS {
 			return convertSlice[nvmlVgpuInstance, VgpuInstance](vgpuInstances[:vgpuCount]), ret
 		}
@@ -1906,6 +2060,9 @@ func (device nvmlDevice) GetActiveVgpus() ([]VgpuInstance, Return) {
 	}
 }
 
+// nvmlDeviceGetActiveVgpusStub allows us to override this for testing.
+var nvmlDeviceGetActiveVgpusStub = nvmlDeviceGetActiveVgpus
+
 // nvml.DeviceGetVgpuMetadata()
 func (l *library) DeviceGetVgpuMetadata(device Device) (VgpuPgpuMetadata, Return) {
 	return device.GetVgpuMetadata()
@@ -1941,7 +2098,7 @@ func (device nvmlDevice) GetPgpuMetadataString() (string, Return) {
 	var bufferSize uint32 = 1 // Will be reduced upon returning
 	for {
 		pgpuMetadata := make([]byte, bufferSize)
-		ret := nvmlDeviceGetPgpuMetadataString(device, &pgpuMetadata[0], &bufferSize)
+		ret := nvmlDeviceGetPgpuMetadataStringStub(device, &pgpuMetadata[0], &bufferSize)
 		if ret == SUCCESS {
 			return string(pgpuMetadata[:clen(pgpuMetadata)]), ret
 		}
@@ -1952,6 +2109,9 @@ func (device nvmlDevice) GetPgpuMetadataString() (string, Return) {
 	}
 }
 
+// nvmlDeviceGetPgpuMetadataStringStub allows us to override this for testing.
+var nvmlDeviceGetPgpuMetadataStringStub = nvmlDeviceGetPgpuMetadataString
+
 // nvml.DeviceGetVgpuUtilization()
 func (l *library) DeviceGetVgpuUtilization(device Device, lastSeenTimestamp uint64) (ValueType, []VgpuInstanceUtilizationSample, Return) {
 	return device.GetVgpuUtilization(lastSeenTimestamp)
@@ -1962,10 +2122,14 @@ func (device nvmlDevice) GetVgpuUtilization(lastSeenTimestamp uint64) (ValueType
 	var vgpuInstanceSamplesCount uint32 = 1 // Will be reduced upon returning
 	for {
 		utilizationSamples := make([]VgpuInstanceUtilizationSample, vgpuInstanceSamplesCount)
-		ret := nvmlDeviceGetVgpuUtilization(device, lastSeenTimestamp, &sampleValType, &vgpuInstanceSamplesCount, &utilizationSamples[0])
+		ret := nvmlDeviceGetVgpuUtilizationStub(device, lastSeenTimestamp, &sampleValType, &vgpuInstanceSamplesCount, &utilizationSamples[0])
 		if ret == SUCCESS {
 			return sampleValType, utilizationSamples[:vgpuInstanceSamplesCount], ret
 		}
+		// No samples were recorded since lastSeenTimestamp.
+		if ret == ERROR_NOT_FOUND {
+			return sampleValType, []VgpuInstanceUtilizationSample{}, SUCCESS
+		}
 		if ret != ERROR_INSUFFICIENT_SIZE {
 			return sampleValType, nil, ret
 		}
@@ -1973,6 +2137,9 @@ func (device nvmlDevice) GetVgpuUtilization(lastSeenTimestamp uint64) (ValueType
 	}
 }
 
+// nvmlDeviceGetVgpuUtilizationStub allows us to override this for testing.
+var nvmlDeviceGetVgpuUtilizationStub = nvmlDeviceGetVgpuUtilization
+
 // nvml.DeviceGetAttributes()
 func (l *library) DeviceGetAttributes(device Device) (DeviceAttributes, Return) {
 	return device.GetAttributes()
@@ -2026,10 +2193,14 @@ func (device nvmlDevice) GetVgpuProcessUtilization(lastSeenTimestamp uint64) ([]
 	var vgpuProcessSamplesCount uint32 = 1 // Will be reduced upon returning
 	for {
 		utilizationSamples := make([]VgpuProcessUtilizationSample, vgpuProcessSamplesCount)
-		ret := nvmlDeviceGetVgpuProcessUtilization(device, lastSeenTimestamp, &vgpuProcessSamplesCount, &utilizationSamples[0])
+		ret := nvmlDeviceGetVgpuProcessUtilizationStub(device, lastSeenTimestamp, &vgpuProcessSamplesCount, &utilizationSamples[0])
 		if ret == SUCCESS {
 			return utilizationSamples[:vgpuProcessSamplesCount], ret
 		}
+		// No samples were recorded since lastSeenTimestamp.
+		if ret == ERROR_NOT_FOUND {
+			return []VgpuProcessUtilizationSample{}, SUCCESS
+		}
 		if ret != ERROR_INSUFFICIENT_SIZE {
 			return nil, ret
 		}
@@ -2037,6 +2208,9 @@ func (device nvmlDevice) GetVgpuProcessUtilization(lastSeenTimestamp uint64) ([]
 	}
 }
 
+// nvmlDeviceGetVgpuProcessUtilizationStub allows us to override this for testing.
+var nvmlDeviceGetVgpuProcessUtilizationStub = nvmlDeviceGetVgpuProcessUtilization
+
 // nvml.GetExcludedDeviceCount()
 func (l *library) GetExcludedDeviceCount() (int, Return) {
 	var deviceCount uint32
@@ -2198,16 +2372,23 @@ func (device nvmlDevice) GetGpuInstances(info *GpuInstanceProfileInfo) ([]GpuIns
 }
 
 // nvml.DeviceGetGpuInstanceById()
+//
+// GPU instance ids remain valid until the instance is destroyed, so they can
+// be persisted and used to recover the handle from another process.
+// ERROR_NOT_FOUND is returned if no GPU instance with the id exists.
 func (l *library) DeviceGetGpuInstanceById(device Device, id int) (GpuInstance, Return) {
 	return device.GetGpuInstanceById(id)
 }
 
 func (device nvmlDevice) GetGpuInstanceById(id int) (GpuInstance, Return) {
 	var gpuInstance nvmlGpuInstance
-	ret := nvmlDeviceGetGpuInstanceById(device, uint32(id), &gpuInstance)
+	ret := nvmlDeviceGetGpuInstanceByIdStub(device, uint32(id), &gpuInstance)
 	return gpuInstance, ret
 }
 
+// nvmlDeviceGetGpuInstanceByIdStub allows us to override this for testing.
+var nvmlDeviceGetGpuInstanceByIdStub = nvmlDeviceGetGpuInstanceById
+
 // nvml.GpuInstanceGetInfo()
 func (l *library) GpuInstanceGetInfo(gpuInstance GpuInstance) (GpuInstanceInfo, Return) {
 	return gpuInstance.GetInfo()
@@ -2226,10 +2407,13 @@ func (l *library) GpuInstanceGetComputeInstanceProfileInfo(gpuInstance GpuInstan
 
 func (gpuInstance nvmlGpuInstance) GetComputeInstanceProfileInfo(profile int, engProfile int) (ComputeInstanceProfileInfo, Return) {
 	var info ComputeInstanceProfileInfo
-	ret := nvmlGpuInstanceGetComputeInstanceProfileInfo(gpuInstance, uint32(profile), uint32(engProfile), &info)
+	ret := nvmlGpuInstanceGetComputeInstanceProfileInfoStub(gpuInstance, uint32(profile), uint32(engProfile), &info)
 	return info, ret
 }
 
+// nvmlGpuInstanceGetComputeInstanceProfileInfoStub allows us to override this for testing.
+var nvmlGpuInstanceGetComputeInstanceProfileInfoStub = nvmlGpuInstanceGetComputeInstanceProfileInfo
+
 // nvml.GpuInstanceGetComputeInstanceProfileInfoV()
 type ComputeInstanceProfileInfoHandler struct {
 	gpuInstance nvmlGpuInstance
@@ -2241,12 +2425,37 @@ func (handler ComputeInstanceProfileInfoHandler) V1() (ComputeInstanceProfileInf
 	return GpuInstanceGetComputeInstanceProfileInfo(handler.gpuInstance, handler.profile, handler.engProfile)
 }
 
+// V2 returns the versioned profile info, which adds the profile name. On
+// drivers without the versioned symbol it falls back to the non-versioned
+// call, leaving the fields it does not report zeroed.
 func (handler ComputeInstanceProfileInfoHandler) V2() (ComputeInstanceProfileInfo_v2, Return) {
 	var info ComputeInstanceProfileInfo_v2
 	info.Version = STRUCT_VERSION(info, 2)
-	ret := nvmlGpuInstanceGetComputeInstanceProfileInfoV(handler.gpuInstance, uint32(handler.profile), uint32(handler.engProfile), &info)
-	return info, ret
-}
+	ret := nvmlGpuInstanceGetComputeInstanceProfileInfoVStub(handler.gpuInstance, uint32(handler.profile), uint32(handler.engProfile), &info)
+	if ret != ERROR_FUNCTION_NOT_FOUND {
+		return info, ret
+	}
+
+	var v1 ComputeInstanceProfileInfo
+	ret = nvmlGpuInstanceGetComputeInstanceProfileInfoStub(handler.gpuInstance, uint32(handler.profile), uint32(handler.engProfile), &v1)
+	if ret != SUCCESS {
+		return ComputeInstanceProfileInfo_v2{}, ret
+	}
+	return ComputeInstanceProfileInfo_v2{
+		Id:                    v1.Id,
+		SliceCount:            v1.SliceCount,
+		InstanceCount:         v1.InstanceCount,
+		MultiprocessorCount:   v1.MultiprocessorCount,
+		SharedCopyEngineCount: v1.SharedCopyEngineCount,
+		SharedDecoderCount:    v1.SharedDecoderCount,
+		SharedEncoderCount:    v1.SharedEncoderCount,
+		SharedJpegCount:       v1.SharedJpegCount,
+		SharedOfaCount:        v1.SharedOfaCount,
+	}, SUCCESS
+}
+
+// nvmlGpuInstanceGetComputeInstanceProfileInfoVStub allows us to override this for testing.
+var nvmlGpuInstanceGetComputeInstanceProfileInfoVStub = nvmlGpuInstanceGetComputeInstanceProfileInfoV
 
 func (l *library) GpuInstanceGetComputeInstanceProfileInfoV(gpuInstance GpuInstance, profile int, engProfile int) ComputeInstanceProfileInfoHandler {
 	return gpuInstance.GetComputeInstanceProfileInfoV(profile, engProfile)
@@ -2309,16 +2518,23 @@ func (gpuInstance nvmlGpuInstance) GetComputeInstances(info *ComputeInstanceProf
 }
 
 // nvml.GpuInstanceGetComputeInstanceById()
+//
+// Like GPU instance ids, compute instance ids remain valid until the instance
+// is destroyed. ERROR_NOT_FOUND is returned if the GPU instance has no compute
+// instance with the id.
 func (l *library) GpuInstanceGetComputeInstanceById(gpuInstance GpuInstance, id int) (ComputeInstance, Return) {
 	return gpuInstance.GetComputeInstanceById(id)
 }
 
 func (gpuInstance nvmlGpuInstance) GetComputeInstanceById(id int) (ComputeInstance, Return) {
 	var computeInstance nvmlComputeInstance
-	ret := nvmlGpuInstanceGetComputeInstanceById(gpuInstance, uint32(id), &computeInstance)
+	ret := nvmlGpuInstanceGetComputeInstanceByIdStub(gpuInstance, uint32(id), &computeInstance)
 	return computeInstance, ret
 }
 
+// nvmlGpuInstanceGetComputeInstanceByIdStub allows us to override this for testing.
+var nvmlGpuInstanceGetComputeInstanceByIdStub = nvmlGpuInstanceGetComputeInstanceById
+
 // nvml.ComputeInstanceGetInfo()
 func (l *library) ComputeInstanceGetInfo(computeInstance ComputeInstance) (ComputeInstanceInfo, Return) {
 	return computeInstance.GetInfo()
@@ -2364,6 +2580,10 @@ func (device nvmlDevice) GetComputeInstanceId() (int, Return) {
 }
 
 // nvml.DeviceGetMaxMigDeviceCount()
+//
+// Older drivers do not implement this call and return
+// ERROR_FUNCTION_NOT_FOUND; GetMigDevices falls back to a fixed upper bound
+// in that case.
 func (l *library) DeviceGetMaxMigDeviceCount(device Device) (int, Return) {
 	return device.GetMaxMigDeviceCount()
 }
@@ -2401,9 +2621,17 @@ func (l *library) DeviceGetBusType(device Device) (BusType, Return) {
 	return device.GetBusType()
 }
 
+// nvmlDeviceGetBusTypeStub allows us to override this for testing.
+var nvmlDeviceGetBusTypeStub = nvmlDeviceGetBusType
+
+// GetBusType returns the type of bus the device is attached to. If the query
+// fails, BUS_TYPE_UNKNOWN is returned along with the error.
 func (device nvmlDevice) GetBusType() (BusType, Return) {
-	var busType BusType
-	ret := nvmlDeviceGetBusType(device, &busType)
+	busType := BusType(BUS_TYPE_UNKNOWN)
+	ret := nvmlDeviceGetBusTypeStub(device, &busType)
+	if ret != SUCCESS {
+		return BUS_TYPE_UNKNOWN, ret
+	}
 	return busType, ret
 }
 
@@ -2417,24 +2645,38 @@ func (device nvmlDevice) SetDefaultFanSpeed_v2(fan int) Return {
 }
 
 // nvml.DeviceGetMinMaxFanSpeed()
+//
+// The speeds are percentages bounding the values accepted by
+// DeviceSetFanSpeed_v2. Devices without controllable fans return
+// ERROR_NOT_SUPPORTED.
 func (l *library) DeviceGetMinMaxFanSpeed(device Device) (int, int, Return) {
 	return device.GetMinMaxFanSpeed()
 }
 
+// nvmlDeviceGetMinMaxFanSpeedStub allows us to override this for testing.
+var nvmlDeviceGetMinMaxFanSpeedStub = nvmlDeviceGetMinMaxFanSpeed
+
 func (device nvmlDevice) GetMinMaxFanSpeed() (int, int, Return) {
 	var minSpeed, maxSpeed uint32
-	ret := nvmlDeviceGetMinMaxFanSpeed(device, &minSpeed, &maxSpeed)
+	ret := nvmlDeviceGetMinMaxFanSpeedStub(device, &minSpeed, &maxSpeed)
 	return int(minSpeed), int(maxSpeed), ret
 }
 
 // nvml.DeviceGetThermalSettings()
+//
+// Pass THERMAL_TARGET_ALL as the sensorIndex to read all sensors. Count is the
+// number of valid entries in Sensor, whose Controller and Target fields hold
+// ThermalController and ThermalTarget values respectively.
 func (l *library) DeviceGetThermalSettings(device Device, sensorIndex uint32) (GpuThermalSettings, Return) {
 	return device.GetThermalSettings(sensorIndex)
 }
 
+// nvmlDeviceGetThermalSettingsStub allows us to override this for testing.
+var nvmlDeviceGetThermalSettingsStub = nvmlDeviceGetThermalSettings
+
 func (device nvmlDevice) GetThermalSettings(sensorIndex uint32) (GpuThermalSettings, Return) {
 	var pThermalSettings GpuThermalSettings
-	ret := nvmlDeviceGetThermalSettings(device, sensorIndex, &pThermalSettings)
+	ret := nvmlDeviceGetThermalSettingsStub(device, sensorIndex, &pThermalSettings)
 	return pThermalSettings, ret
 }
 
@@ -2450,13 +2692,18 @@ func (device nvmlDevice) GetDefaultEccMode() (EnableState, Return) {
 }
 
 // nvml.DeviceGetPcieSpeed()
+//
+// The speed is the current PCIe link speed in MBps.
 func (l *library) DeviceGetPcieSpeed(device Device) (int, Return) {
 	return device.GetPcieSpeed()
 }
 
+// nvmlDeviceGetPcieSpeedStub allows us to override this for testing.
+var nvmlDeviceGetPcieSpeedStub = nvmlDeviceGetPcieSpeed
+
 func (device nvmlDevice) GetPcieSpeed() (int, Return) {
 	var pcieSpeed uint32
-	ret := nvmlDeviceGetPcieSpeed(device, &pcieSpeed)
+	ret := nvmlDeviceGetPcieSpeedStub(device, &pcieSpeed)
 	return int(pcieSpeed), ret
 }
 
@@ -2507,13 +2754,20 @@ func (l *library) DeviceGetGpcClkVfOffset(device Device) (int, Return) {
 	return device.GetGpcClkVfOffset()
 }
 
+// nvmlDeviceGetGpcClkVfOffsetStub allows us to override this for testing.
+var nvmlDeviceGetGpcClkVfOffsetStub = nvmlDeviceGetGpcClkVfOffset
+
 func (device nvmlDevice) GetGpcClkVfOffset() (int, Return) {
 	var offset int32
-	ret := nvmlDeviceGetGpcClkVfOffset(device, &offset)
+	ret := nvmlDeviceGetGpcClkVfOffsetStub(device, &offset)
 	return int(offset), ret
 }
 
 // nvml.DeviceSetGpcClkVfOffset()
+//
+// The offset is in MHz and must lie within the range reported by
+// DeviceGetGpcClkMinMaxVfOffset. Setting it requires root and returns
+// ERROR_NO_PERMISSION otherwise; datacenter GPUs return ERROR_NOT_SUPPORTED.
 func (l *library) DeviceSetGpcClkVfOffset(device Device, offset int) Return {
 	return device.SetGpcClkVfOffset(offset)
 }
@@ -2534,13 +2788,24 @@ func (device nvmlDevice) GetMinMaxClockOfPState(clockType ClockType, pstate Psta
 }
 
 // nvml.DeviceGetSupportedPerformanceStates()
+//
+// Devices that do not support performance state readings return a nil slice
+// and ERROR_NOT_SUPPORTED.
 func (l *library) DeviceGetSupportedPerformanceStates(device Device) ([]Pstates, Return) {
 	return device.GetSupportedPerformanceStates()
 }
 
 func (device nvmlDevice) GetSupportedPerformanceStates() ([]Pstates, Return) {
 	pstates := make([]Pstates, MAX_GPU_PERF_PSTATES)
-	ret := nvmlDeviceGetSupportedPerformanceStates(device, &pstates[0], MAX_GPU_PERF_PSTATES)
+	for i := range pstates {
+		pstates[i] = PSTATE_UNKNOWN
+	}
+	// The size of the pstates array is specified in bytes.
+	size := uint32(len(pstates)) * uint32(unsafe.Sizeof(pstates[0]))
+	ret := nvmlDeviceGetSupportedPerformanceStatesStub(device, &pstates[0], size)
+	if ret != SUCCESS {
+		return nil, ret
+	}
 	for i := 0; i < MAX_GPU_PERF_PSTATES; i++ {
 		if pstates[i] == PSTATE_UNKNOWN {
 			return pstates[0:i], ret
@@ -2549,6 +2814,9 @@ func (device nvmlDevice) GetSupportedPerformanceStates() ([]Pstates, Return) {
 	return pstates, ret
 }
 
+// nvmlDeviceGetSupportedPerformanceStatesStub allows us to override this for testing.
+var nvmlDeviceGetSupportedPerformanceStatesStub = nvmlDeviceGetSupportedPerformanceStates
+
 // nvml.DeviceGetTargetFanSpeed()
 func (l *library) DeviceGetTargetFanSpeed(device Device, fan int) (int, Return) {
 	return device.GetTargetFanSpeed(fan)
@@ -2565,13 +2833,20 @@ func (l *library) DeviceGetMemClkVfOffset(device Device) (int, Return) {
 	return device.GetMemClkVfOffset()
 }
 
+// nvmlDeviceGetMemClkVfOffsetStub allows us to override this for testing.
+var nvmlDeviceGetMemClkVfOffsetStub = nvmlDeviceGetMemClkVfOffset
+
 func (device nvmlDevice) GetMemClkVfOffset() (int, Return) {
 	var offset int32
-	ret := nvmlDeviceGetMemClkVfOffset(device, &offset)
+	ret := nvmlDeviceGetMemClkVfOffsetStub(device, &offset)
 	return int(offset), ret
 }
 
 // nvml.DeviceSetMemClkVfOffset()
+//
+// The offset is in MHz and must lie within the range reported by
+// DeviceGetMemClkMinMaxVfOffset. Setting it requires root and returns
+// ERROR_NO_PERMISSION otherwise; datacenter GPUs return ERROR_NOT_SUPPORTED.
 func (l *library) DeviceSetMemClkVfOffset(device Device, offset int) Return {
 	return device.SetMemClkVfOffset(offset)
 }
@@ -2581,28 +2856,42 @@ func (device nvmlDevice) SetMemClkVfOffset(offset int) Return {
 }
 
 // nvml.DeviceGetGpcClkMinMaxVfOffset()
+//
+// The returned range bounds the offsets accepted by DeviceSetGpcClkVfOffset.
 func (l *library) DeviceGetGpcClkMinMaxVfOffset(device Device) (int, int, Return) {
 	return device.GetGpcClkMinMaxVfOffset()
 }
 
+// nvmlDeviceGetGpcClkMinMaxVfOffsetStub allows us to override this for testing.
+var nvmlDeviceGetGpcClkMinMaxVfOffsetStub = nvmlDeviceGetGpcClkMinMaxVfOffset
+
 func (device nvmlDevice) GetGpcClkMinMaxVfOffset() (int, int, Return) {
 	var minOffset, maxOffset int32
-	ret := nvmlDeviceGetGpcClkMinMaxVfOffset(device, &minOffset, &maxOffset)
+	ret := nvmlDeviceGetGpcClkMinMaxVfOffsetStub(device, &minOffset, &maxOffset)
 	return int(minOffset), int(maxOffset), ret
 }
 
 // nvml.DeviceGetMemClkMinMaxVfOffset()
+//
+// The returned range bounds the offsets accepted by DeviceSetMemClkVfOffset.
 func (l *library) DeviceGetMemClkMinMaxVfOffset(device Device) (int, int, Return) {
 	return device.GetMemClkMinMaxVfOffset()
 }
 
+// nvmlDeviceGetMemClkMinMaxVfOffsetStub allows us to override this for testing.
+var nvmlDeviceGetMemClkMinMaxVfOffsetStub = nvmlDeviceGetMemClkMinMaxVfOffset
+
 func (device nvmlDevice) GetMemClkMinMaxVfOffset() (int, int, Return) {
 	var minOffset, maxOffset int32
-	ret := nvmlDeviceGetMemClkMinMaxVfOffset(device, &minOffset, &maxOffset)
+	ret := nvmlDeviceGetMemClkMinMaxVfOffsetStub(device, &minOffset, &maxOffset)
 	return int(minOffset), int(maxOffset), ret
 }
 
 // nvml.DeviceGetGpuMaxPcieLinkGeneration()
+//
+// Unlike DeviceGetMaxPcieLinkGeneration, which reports the maximum
+// generation possible with the GPU and the system it is installed in, this
+// reports the maximum generation supported by the GPU alone.
 func (l *library) DeviceGetGpuMaxPcieLinkGeneration(device Device) (int, Return) {
 	return device.GetGpuMaxPcieLinkGeneration()
 }
@@ -2640,7 +2929,10 @@ func (l *library) DeviceClearFieldValues(device Device, values []FieldValue) Ret
 
 func (device nvmlDevice) ClearFieldValues(values []FieldValue) Return {
 	valuesCount := len(values)
-	return nvmlDeviceClearFieldValues(device, int32(valuesCount), &values[0])
+	if valuesCount == 0 {
+		return SUCCESS
+	}
+	return deviceClearFieldValues(device, int32(valuesCount), &values[0])
 }
 
 // nvml.DeviceGetVgpuCapabilities()
@@ -2764,7 +3056,7 @@ func (l *library) DeviceGetCurrentClocksEventReasons(device Device) (uint64, Ret
 
 func (device nvmlDevice) GetCurrentClocksEventReasons() (uint64, Return) {
 	var clocksEventReasons uint64
-	ret := nvmlDeviceGetCurrentClocksEventReasons(device, &clocksEventReasons)
+	ret := deviceGetCurrentClocksEventReasons(device, &clocksEventReasons)
 	return clocksEventReasons, ret
 }
 
@@ -2775,32 +3067,46 @@ func (l *library) DeviceGetSupportedClocksEventReasons(device Device) (uint64, R
 
 func (device nvmlDevice) GetSupportedClocksEventReasons() (uint64, Return) {
 	var supportedClocksEventReasons uint64
-	ret := nvmlDeviceGetSupportedClocksEventReasons(device, &supportedClocksEventReasons)
+	ret := deviceGetSupportedClocksEventReasons(device, &supportedClocksEventReasons)
 	return supportedClocksEventReasons, ret
 }
 
 // nvml.DeviceGetJpgUtilization()
+//
+// Returns the utilization of the JPEG decoder in percent, together with the
+// sampling period in microseconds it was measured over. GPUs without the
+// engine return ERROR_NOT_SUPPORTED.
 func (l *library) DeviceGetJpgUtilization(device Device) (uint32, uint32, Return) {
 	return device.GetJpgUtilization()
 }
 
 func (device nvmlDevice) GetJpgUtilization() (uint32, uint32, Return) {
 	var utilization, samplingPeriodUs uint32
-	ret := nvmlDeviceGetJpgUtilization(device, &utilization, &samplingPeriodUs)
+	ret := nvmlDeviceGetJpgUtilizationStub(device, &utilization, &samplingPeriodUs)
 	return utilization, samplingPeriodUs, ret
 }
 
+// nvmlDeviceGetJpgUtilizationStub allows us to override this for testing.
+var nvmlDeviceGetJpgUtilizationStub = nvmlDeviceGetJpgUtilization
+
 // nvml.DeviceGetOfaUtilization()
+//
+// Returns the utilization of the optical flow accelerator in percent, together with the
+// sampling period in microseconds it was measured over. GPUs without the
+// engine return ERROR_NOT_SUPPORTED.
 func (l *library) DeviceGetOfaUtilization(device Device) (uint32, uint32, Return) {
 	return device.GetOfaUtilization()
 }
 
 func (device nvmlDevice) GetOfaUtilization() (uint32, uint32, Return) {
 	var utilization, samplingPeriodUs uint32
-	ret := nvmlDeviceGetOfaUtilization(device, &utilization, &samplingPeriodUs)
+	ret := nvmlDeviceGetOfaUtilizationStub(device, &utilization, &samplingPeriodUs)
 	return utilization, samplingPeriodUs, ret
 }
 
+// nvmlDeviceGetOfaUtilizationStub allows us to override this for testing.
+var nvmlDeviceGetOfaUtilizationStub = nvmlDeviceGetOfaUtilization
+
 // nvml.DeviceGetRunningProcessDetailList()
 func (l *library) DeviceGetRunningProcessDetailList(device Device) (ProcessDetailList, Return) {
 	return device.GetRunningProcessDetailList()
@@ -2809,18 +3115,24 @@ func (l *library) DeviceGetRunningProcessDetailList(device Device) (ProcessDetai
 func (device nvmlDevice) GetRunningProcessDetailList() (ProcessDetailList, Return) {
 	var plist ProcessDetailList
 	plist.Version = STRUCT_VERSION(plist, 1)
-	ret := nvmlDeviceGetRunningProcessDetailList(device, &plist)
+	ret := deviceGetRunningProcessDetailList(device, &plist)
 	return plist, ret
 }
 
 // nvml.DeviceGetConfComputeMemSizeInfo()
+//
+// The protected and unprotected memory sizes are reported in KiB. Devices
+// without confidential compute support return ERROR_NOT_SUPPORTED.
 func (l *library) DeviceGetConfComputeMemSizeInfo(device Device) (ConfComputeMemSizeInfo, Return) {
 	return device.GetConfComputeMemSizeInfo()
 }
 
+// nvmlDeviceGetConfComputeMemSizeInfoStub allows us to override this for testing.
+var nvmlDeviceGetConfComputeMemSizeInfoStub = nvmlDeviceGetConfComputeMemSizeInfo
+
 func (device nvmlDevice) GetConfComputeMemSizeInfo() (ConfComputeMemSizeInfo, Return) {
 	var memInfo ConfComputeMemSizeInfo
-	ret := nvmlDeviceGetConfComputeMemSizeInfo(device, &memInfo)
+	ret := nvmlDeviceGetConfComputeMemSizeInfoStub(device, &memInfo)
 	return memInfo, ret
 }
 
@@ -2925,7 +3237,7 @@ func (l *library) DeviceGetPciInfoExt(device Device) (PciInfoExt, Return) {
 func (device nvmlDevice) GetPciInfoExt() (PciInfoExt, Return) {
 	var pciInfo PciInfoExt
 	pciInfo.Version = STRUCT_VERSION(pciInfo, 1)
-	ret := nvmlDeviceGetPciInfoExt(device, &pciInfo)
+	ret := deviceGetPciInfoExt(device, &pciInfo)
 	return pciInfo, ret
 }
 
@@ -2954,14 +3266,30 @@ func (device nvmlDevice) GetGpuFabricInfoV() GpuFabricInfoHandler {
 }
 
 // nvml.DeviceGetProcessesUtilizationInfo()
-func (l *library) DeviceGetProcessesUtilizationInfo(device Device) (ProcessesUtilizationInfo, Return) {
-	return device.GetProcessesUtilizationInfo()
+func (l *library) DeviceGetProcessesUtilizationInfo(device Device, lastSeenTimestamp uint64) (ProcessesUtilizationInfo, []ProcessUtilizationInfo_v1, Return) {
+	return device.GetProcessesUtilizationInfo(lastSeenTimestamp)
 }
 
-func (device nvmlDevice) GetProcessesUtilizationInfo() (ProcessesUtilizationInfo, Return) {
+func (device nvmlDevice) GetProcessesUtilizationInfo(lastSeenTimestamp uint64) (ProcessesUtilizationInfo, []ProcessUtilizationInfo_v1, Return) {
 	var processesUtilInfo ProcessesUtilizationInfo
-	ret := nvmlDeviceGetProcessesUtilizationInfo(device, &processesUtilInfo)
-	return processesUtilInfo, ret
+	processesUtilInfo.Version = STRUCT_VERSION(processesUtilInfo, 1)
+	processesUtilInfo.LastSeenTimeStamp = lastSeenTimestamp
+	ret := deviceGetProcessesUtilizationInfo(device, &processesUtilInfo)
+	for ret == ERROR_INSUFFICIENT_SIZE && processesUtilInfo.ProcessSamplesCount > 0 {
+		procUtilArray, free := cArray[ProcessUtilizationInfo_v1](int(processesUtilInfo.ProcessSamplesCount))
+		processesUtilInfo.LastSeenTimeStamp = lastSeenTimestamp
+		processesUtilInfo.ProcUtilArray = &procUtilArray[0]
+		ret = deviceGetProcessesUtilizationInfo(device, &processesUtilInfo)
+		processesUtilInfo.ProcUtilArray = nil
+		if ret == SUCCESS {
+			procUtil := make([]ProcessUtilizationInfo_v1, processesUtilInfo.ProcessSamplesCount)
+			copy(procUtil, procUtilArray)
+			free()
+			return processesUtilInfo, procUtil, ret
+		}
+		free()
+	}
+	return processesUtilInfo, nil, ret
 }
 
 // nvml.DeviceGetVgpuHeterogeneousMode()
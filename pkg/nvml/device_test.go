@@ -18,6 +18,7 @@ package nvml
 
 import (
 	"testing"
+	"unsafe"
 
 	"github.com/stretchr/testify/require"
 )
@@ -97,3 +98,1532 @@ func setNvmlDeviceGetTopologyCommonAncestorStubForTest(ret Return) func() {
 		nvmlDeviceGetTopologyCommonAncestorStub = original
 	}
 }
+
+func TestGetSerial(t *testing.T) {
+	testCases := []struct {
+		description    string
+		serial         string
+		ret            Return
+		expectedSerial string
+	}{
+		{
+			description:    "serial is returned",
+			serial:         "1652020026833",
+			ret:            SUCCESS,
+			expectedSerial: "1652020026833",
+		},
+		{
+			description:    "buffer is truncated at the first NUL",
+			serial:         "1652020026833\x00garbage",
+			ret:            SUCCESS,
+			expectedSerial: "1652020026833",
+		},
+		{
+			description:    "not supported on boards without a serial",
+			ret:            ERROR_NOT_SUPPORTED,
+			expectedSerial: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			defer setNvmlDeviceGetSerialStubForTest(tc.serial, tc.ret)()
+
+			serial, ret := nvmlDevice{}.GetSerial()
+			require.Equal(t, tc.ret, ret)
+			require.Equal(t, tc.expectedSerial, serial)
+		})
+	}
+}
+
+func setNvmlDeviceGetSerialStubForTest(serial string, ret Return) func() {
+	original := nvmlDeviceGetSerialStub
+
+	nvmlDeviceGetSerialStub = func(device nvmlDevice, buffer *byte, length uint32) Return {
+		copy(unsafe.Slice(buffer, length), serial)
+		return ret
+	}
+	return func() {
+		nvmlDeviceGetSerialStub = original
+	}
+}
+
+func TestDeviceGetHandleBySerial(t *testing.T) {
+	testCases := []struct {
+		description string
+		serial      string
+		ret         Return
+	}{
+		{
+			description: "device is found",
+			serial:      "1652020026833",
+			ret:         SUCCESS,
+		},
+		{
+			description: "device is not found",
+			serial:      "0000000000000",
+			ret:         ERROR_NOT_FOUND,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			var requested string
+			original := nvmlDeviceGetHandleBySerialStub
+			defer func() { nvmlDeviceGetHandleBySerialStub = original }()
+			nvmlDeviceGetHandleBySerialStub = func(serial string, device *nvmlDevice) Return {
+				requested = serial
+				return tc.ret
+			}
+
+			device, ret := newLibrary().DeviceGetHandleBySerial(tc.serial)
+			require.Equal(t, tc.ret, ret)
+			require.IsType(t, nvmlDevice{}, device)
+			require.Equal(t, tc.serial+"\x00", requested)
+		})
+	}
+}
+
+func TestDeviceGetHandleByPciBusId(t *testing.T) {
+	testCases := []struct {
+		description string
+		pciBusId    string
+		ret         Return
+	}{
+		{
+			description: "device is found",
+			pciBusId:    "00000000:07:00.0",
+			ret:         SUCCESS,
+		},
+		{
+			description: "device is not found",
+			pciBusId:    "00000000:FF:00.0",
+			ret:         ERROR_NOT_FOUND,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			var requested string
+			original := nvmlDeviceGetHandleByPciBusId
+			defer func() { nvmlDeviceGetHandleByPciBusId = original }()
+			nvmlDeviceGetHandleByPciBusId = func(pciBusId string, device *nvmlDevice) Return {
+				requested = pciBusId
+				return tc.ret
+			}
+
+			device, ret := newLibrary().DeviceGetHandleByPciBusId(tc.pciBusId)
+			require.Equal(t, tc.ret, ret)
+			require.IsType(t, nvmlDevice{}, device)
+			require.Equal(t, tc.pciBusId+"\x00", requested)
+		})
+	}
+}
+
+func TestGetNvLinkRemotePciInfo(t *testing.T) {
+	remotes := map[uint32]PciInfo{
+		// An A100 GPU on the far end of the link.
+		0: {Bus: 0x07, PciDeviceId: 0x20B010DE},
+		// An NVSwitch on the far end of the link.
+		1: {Bus: 0xC5, PciDeviceId: 0x1AF110DE},
+	}
+
+	original := nvmlDeviceGetNvLinkRemotePciInfo
+	defer func() { nvmlDeviceGetNvLinkRemotePciInfo = original }()
+	nvmlDeviceGetNvLinkRemotePciInfo = func(device nvmlDevice, link uint32, pci *PciInfo) Return {
+		remote, exists := remotes[link]
+		if !exists {
+			return ERROR_NOT_SUPPORTED
+		}
+		*pci = remote
+		return SUCCESS
+	}
+
+	testCases := []struct {
+		description string
+		link        int
+		expected    PciInfo
+		ret         Return
+	}{
+		{
+			description: "link to a GPU",
+			link:        0,
+			expected:    remotes[0],
+			ret:         SUCCESS,
+		},
+		{
+			description: "link to an NVSwitch",
+			link:        1,
+			expected:    remotes[1],
+			ret:         SUCCESS,
+		},
+		{
+			description: "inactive link",
+			link:        2,
+			ret:         ERROR_NOT_SUPPORTED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			pci, ret := nvmlDevice{}.GetNvLinkRemotePciInfo(tc.link)
+			require.Equal(t, tc.ret, ret)
+			require.Equal(t, tc.expected, pci)
+		})
+	}
+}
+
+func TestGetNvLinkErrorCounter(t *testing.T) {
+	counters := map[NvLinkErrorCounter]uint64{
+		NVLINK_ERROR_DL_CRC_FLIT: 12,
+		NVLINK_ERROR_DL_CRC_DATA: 3,
+	}
+
+	original := nvmlDeviceGetNvLinkErrorCounterStub
+	defer func() { nvmlDeviceGetNvLinkErrorCounterStub = original }()
+	nvmlDeviceGetNvLinkErrorCounterStub = func(device nvmlDevice, link uint32, counter NvLinkErrorCounter, counterValue *uint64) Return {
+		if link != 0 {
+			return ERROR_NOT_SUPPORTED
+		}
+		*counterValue = counters[counter]
+		return SUCCESS
+	}
+
+	for counter, expected := range counters {
+		t.Run(counter.String(), func(t *testing.T) {
+			value, ret := nvmlDevice{}.GetNvLinkErrorCounter(0, counter)
+			require.Equal(t, SUCCESS, ret)
+			require.Equal(t, expected, value)
+		})
+	}
+
+	t.Run("inactive link", func(t *testing.T) {
+		value, ret := nvmlDevice{}.GetNvLinkErrorCounter(1, NVLINK_ERROR_DL_CRC_FLIT)
+		require.Equal(t, ERROR_NOT_SUPPORTED, ret)
+		require.Zero(t, value)
+	})
+}
+
+func TestGetNvLinkStateAndVersion(t *testing.T) {
+	type link struct {
+		state   EnableState
+		version uint32
+	}
+	links := map[uint32]link{
+		0: {state: FEATURE_ENABLED, version: 4},
+		1: {state: FEATURE_DISABLED, version: 4},
+	}
+
+	originalState := nvmlDeviceGetNvLinkStateStub
+	originalVersion := nvmlDeviceGetNvLinkVersionStub
+	defer func() {
+		nvmlDeviceGetNvLinkStateStub = originalState
+		nvmlDeviceGetNvLinkVersionStub = originalVersion
+	}()
+	nvmlDeviceGetNvLinkStateStub = func(device nvmlDevice, index uint32, isActive *EnableState) Return {
+		l, exists := links[index]
+		if !exists {
+			return ERROR_NOT_SUPPORTED
+		}
+		*isActive = l.state
+		return SUCCESS
+	}
+	nvmlDeviceGetNvLinkVersionStub = func(device nvmlDevice, index uint32, version *uint32) Return {
+		l, exists := links[index]
+		if !exists {
+			return ERROR_NOT_SUPPORTED
+		}
+		*version = l.version
+		return SUCCESS
+	}
+
+	testCases := []struct {
+		description     string
+		link            int
+		expectedState   EnableState
+		expectedVersion uint32
+		ret             Return
+	}{
+		{
+			description:     "active link",
+			link:            0,
+			expectedState:   FEATURE_ENABLED,
+			expectedVersion: 4,
+			ret:             SUCCESS,
+		},
+		{
+			description:     "inactive link",
+			link:            1,
+			expectedState:   FEATURE_DISABLED,
+			expectedVersion: 4,
+			ret:             SUCCESS,
+		},
+		{
+			description: "no NVLink",
+			link:        2,
+			ret:         ERROR_NOT_SUPPORTED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			state, ret := nvmlDevice{}.GetNvLinkState(tc.link)
+			require.Equal(t, tc.ret, ret)
+			require.Equal(t, tc.expectedState, state)
+
+			version, ret := nvmlDevice{}.GetNvLinkVersion(tc.link)
+			require.Equal(t, tc.ret, ret)
+			require.Equal(t, tc.expectedVersion, version)
+		})
+	}
+}
+
+func TestGetCurrentClocksThrottleReasons(t *testing.T) {
+	testCases := []struct {
+		description         string
+		eventReasonsRet     Return
+		eventReasons        uint64
+		throttleReasons     uint64
+		expectedReasons     uint64
+		expectThrottleCalls int
+	}{
+		{
+			description:         "event reasons are used when present",
+			eventReasonsRet:     SUCCESS,
+			eventReasons:        ClocksEventReasonSwPowerCap,
+			expectedReasons:     ClocksEventReasonSwPowerCap,
+			expectThrottleCalls: 0,
+		},
+		{
+			description:         "throttle reasons are used on older drivers",
+			eventReasonsRet:     ERROR_FUNCTION_NOT_FOUND,
+			throttleReasons:     ClocksThrottleReasonHwThermalSlowdown,
+			expectedReasons:     ClocksThrottleReasonHwThermalSlowdown,
+			expectThrottleCalls: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			originalEventReasons := deviceGetCurrentClocksEventReasons
+			originalThrottleReasons := nvmlDeviceGetCurrentClocksThrottleReasonsStub
+			defer func() {
+				deviceGetCurrentClocksEventReasons = originalEventReasons
+				nvmlDeviceGetCurrentClocksThrottleReasonsStub = originalThrottleReasons
+			}()
+
+			deviceGetCurrentClocksEventReasons = func(device nvmlDevice, reasons *uint64) Return {
+				*reasons = tc.eventReasons
+				return tc.eventReasonsRet
+			}
+			throttleCalls := 0
+			nvmlDeviceGetCurrentClocksThrottleReasonsStub = func(device nvmlDevice, reasons *uint64) Return {
+				throttleCalls++
+				*reasons = tc.throttleReasons
+				return SUCCESS
+			}
+
+			reasons, ret := nvmlDevice{}.GetCurrentClocksThrottleReasons()
+			require.Equal(t, SUCCESS, ret)
+			require.Equal(t, tc.expectedReasons, reasons)
+			require.Equal(t, tc.expectThrottleCalls, throttleCalls)
+		})
+	}
+}
+
+func TestGetProcessesUtilizationInfo(t *testing.T) {
+	samples := []ProcessUtilizationInfo_v1{
+		{TimeStamp: 200, Pid: 100, SmUtil: 50, MemUtil: 20},
+		{TimeStamp: 300, Pid: 101, SmUtil: 10, EncUtil: 5},
+	}
+
+	original := deviceGetProcessesUtilizationInfo
+	defer func() {
+		deviceGetProcessesUtilizationInfo = original
+	}()
+
+	testCases := []struct {
+		description       string
+		samples           []ProcessUtilizationInfo_v1
+		ret               Return
+		expectedProcUtil  []ProcessUtilizationInfo_v1
+		expectedLastSeen  uint64
+		expectedRet       Return
+		expectedCallCount int
+	}{
+		{
+			description:       "samples are returned",
+			samples:           samples,
+			ret:               SUCCESS,
+			expectedProcUtil:  samples,
+			expectedLastSeen:  300,
+			expectedRet:       SUCCESS,
+			expectedCallCount: 2,
+		},
+		{
+			description:       "no samples",
+			ret:               SUCCESS,
+			expectedLastSeen:  100,
+			expectedRet:       SUCCESS,
+			expectedCallCount: 1,
+		},
+		{
+			description:       "missing symbol",
+			ret:               ERROR_FUNCTION_NOT_FOUND,
+			expectedLastSeen:  100,
+			expectedRet:       ERROR_FUNCTION_NOT_FOUND,
+			expectedCallCount: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			callCount := 0
+			deviceGetProcessesUtilizationInfo = func(device nvmlDevice, processesUtilInfo *ProcessesUtilizationInfo) Return {
+				callCount++
+				require.Equal(t, STRUCT_VERSION(ProcessesUtilizationInfo{}, 1), processesUtilInfo.Version)
+				require.Equal(t, uint64(100), processesUtilInfo.LastSeenTimeStamp)
+				if tc.ret != SUCCESS {
+					return tc.ret
+				}
+				count := uint32(len(tc.samples))
+				if processesUtilInfo.ProcUtilArray == nil || processesUtilInfo.ProcessSamplesCount < count {
+					processesUtilInfo.ProcessSamplesCount = count
+					if count > 0 {
+						return ERROR_INSUFFICIENT_SIZE
+					}
+					return SUCCESS
+				}
+				copy(unsafe.Slice(processesUtilInfo.ProcUtilArray, count), tc.samples)
+				processesUtilInfo.ProcessSamplesCount = count
+				processesUtilInfo.LastSeenTimeStamp = tc.samples[count-1].TimeStamp
+				return SUCCESS
+			}
+
+			processesUtilInfo, procUtil, ret := nvmlDevice{}.GetProcessesUtilizationInfo(100)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expectedProcUtil, procUtil)
+			require.Equal(t, tc.expectedLastSeen, processesUtilInfo.LastSeenTimeStamp)
+			require.Nil(t, processesUtilInfo.ProcUtilArray)
+			require.Equal(t, tc.expectedCallCount, callCount)
+		})
+	}
+}
+
+func TestGetSupportedMemoryClocks(t *testing.T) {
+	original := nvmlDeviceGetSupportedMemoryClocksStub
+	defer func() {
+		nvmlDeviceGetSupportedMemoryClocksStub = original
+	}()
+
+	testCases := []struct {
+		description    string
+		clocks         []uint32
+		ret            Return
+		expectedClocks []uint32
+		expectedRet    Return
+	}{
+		{
+			description:    "all clocks are returned",
+			clocks:         []uint32{1215, 877, 405},
+			ret:            SUCCESS,
+			expectedClocks: []uint32{1215, 877, 405},
+			expectedRet:    SUCCESS,
+		},
+		{
+			description:    "no clocks",
+			ret:            SUCCESS,
+			expectedClocks: []uint32{},
+			expectedRet:    SUCCESS,
+		},
+		{
+			description: "not supported",
+			ret:         ERROR_NOT_SUPPORTED,
+			expectedRet: ERROR_NOT_SUPPORTED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			nvmlDeviceGetSupportedMemoryClocksStub = func(device nvmlDevice, count *uint32, clocksMHz *uint32) Return {
+				if tc.ret != SUCCESS {
+					return tc.ret
+				}
+				required := uint32(len(tc.clocks))
+				if *count < required {
+					*count = required
+					return ERROR_INSUFFICIENT_SIZE
+				}
+				copy(unsafe.Slice(clocksMHz, *count), tc.clocks)
+				*count = required
+				return SUCCESS
+			}
+
+			count, clocks, ret := nvmlDevice{}.GetSupportedMemoryClocks()
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, len(tc.expectedClocks), count)
+			require.Equal(t, tc.expectedClocks, clocks)
+		})
+	}
+}
+
+func TestClearEccErrorCounts(t *testing.T) {
+	original := nvmlDeviceClearEccErrorCountsStub
+	defer func() {
+		nvmlDeviceClearEccErrorCountsStub = original
+	}()
+
+	testCases := []struct {
+		description string
+		ret         Return
+	}{
+		{
+			description: "counters cleared",
+			ret:         SUCCESS,
+		},
+		{
+			description: "not running as root",
+			ret:         ERROR_NO_PERMISSION,
+		},
+		{
+			description: "no ECC support",
+			ret:         ERROR_NOT_SUPPORTED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			var cleared []EccCounterType
+			nvmlDeviceClearEccErrorCountsStub = func(device nvmlDevice, counterType EccCounterType) Return {
+				cleared = append(cleared, counterType)
+				return tc.ret
+			}
+
+			ret := nvmlDevice{}.ClearEccErrorCounts(VOLATILE_ECC)
+			require.Equal(t, tc.ret, ret)
+			require.Equal(t, []EccCounterType{VOLATILE_ECC}, cleared)
+		})
+	}
+}
+
+func TestGetEccMode(t *testing.T) {
+	original := nvmlDeviceGetEccModeStub
+	defer func() {
+		nvmlDeviceGetEccModeStub = original
+	}()
+
+	testCases := []struct {
+		description string
+		current     EnableState
+		pending     EnableState
+		ret         Return
+	}{
+		{
+			description: "enabled",
+			current:     FEATURE_ENABLED,
+			pending:     FEATURE_ENABLED,
+			ret:         SUCCESS,
+		},
+		{
+			description: "disable pending reset",
+			current:     FEATURE_ENABLED,
+			pending:     FEATURE_DISABLED,
+			ret:         SUCCESS,
+		},
+		{
+			description: "no ECC support",
+			ret:         ERROR_NOT_SUPPORTED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			nvmlDeviceGetEccModeStub = func(device nvmlDevice, current *EnableState, pending *EnableState) Return {
+				*current = tc.current
+				*pending = tc.pending
+				return tc.ret
+			}
+
+			current, pending, ret := nvmlDevice{}.GetEccMode()
+			require.Equal(t, tc.ret, ret)
+			require.Equal(t, tc.current, current)
+			require.Equal(t, tc.pending, pending)
+		})
+	}
+}
+
+func TestGetPowerState(t *testing.T) {
+	original := nvmlDeviceGetPerformanceStateStub
+	defer func() {
+		nvmlDeviceGetPerformanceStateStub = original
+	}()
+
+	testCases := []struct {
+		pState Pstates
+		ret    Return
+	}{
+		{PSTATE_0, SUCCESS},
+		{PSTATE_8, SUCCESS},
+		{PSTATE_UNKNOWN, ERROR_NOT_SUPPORTED},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.pState.String(), func(t *testing.T) {
+			nvmlDeviceGetPerformanceStateStub = func(device nvmlDevice, pState *Pstates) Return {
+				*pState = tc.pState
+				return tc.ret
+			}
+
+			expectedPState, expectedRet := nvmlDevice{}.GetPerformanceState()
+			pState, ret := nvmlDevice{}.GetPowerState()
+			require.Equal(t, expectedRet, ret)
+			require.Equal(t, expectedPState, pState)
+			require.Equal(t, tc.pState, pState)
+		})
+	}
+}
+
+func TestGetSupportedPerformanceStates(t *testing.T) {
+	original := nvmlDeviceGetSupportedPerformanceStatesStub
+	defer func() {
+		nvmlDeviceGetSupportedPerformanceStatesStub = original
+	}()
+
+	testCases := []struct {
+		description     string
+		supported       []Pstates
+		ret             Return
+		expectedPstates []Pstates
+	}{
+		{
+			description:     "subset of pstates",
+			supported:       []Pstates{PSTATE_0, PSTATE_2, PSTATE_5, PSTATE_8, PSTATE_12},
+			ret:             SUCCESS,
+			expectedPstates: []Pstates{PSTATE_0, PSTATE_2, PSTATE_5, PSTATE_8, PSTATE_12},
+		},
+		{
+			description: "not supported",
+			ret:         ERROR_NOT_SUPPORTED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			nvmlDeviceGetSupportedPerformanceStatesStub = func(device nvmlDevice, pstates *Pstates, size uint32) Return {
+				if tc.ret != SUCCESS {
+					return tc.ret
+				}
+				if int(size) < len(tc.supported)*int(unsafe.Sizeof(*pstates)) {
+					return ERROR_INSUFFICIENT_SIZE
+				}
+				copy(unsafe.Slice(pstates, MAX_GPU_PERF_PSTATES), tc.supported)
+				return SUCCESS
+			}
+
+			pstates, ret := nvmlDevice{}.GetSupportedPerformanceStates()
+			require.Equal(t, tc.ret, ret)
+			require.Equal(t, tc.expectedPstates, pstates)
+		})
+	}
+}
+
+func TestGetPgpuMetadataString(t *testing.T) {
+	original := nvmlDeviceGetPgpuMetadataStringStub
+	defer func() {
+		nvmlDeviceGetPgpuMetadataStringStub = original
+	}()
+
+	testCases := []struct {
+		description      string
+		metadata         string
+		ret              Return
+		expectedMetadata string
+	}{
+		{
+			description:      "metadata larger than the initial buffer",
+			metadata:         "version=1;host=550.54.15;guest=550.54.15",
+			ret:              SUCCESS,
+			expectedMetadata: "version=1;host=550.54.15;guest=550.54.15",
+		},
+		{
+			description: "non-vGPU host",
+			ret:         ERROR_NOT_SUPPORTED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			nvmlDeviceGetPgpuMetadataStringStub = func(device nvmlDevice, pgpuMetadata *byte, bufferSize *uint32) Return {
+				if tc.ret != SUCCESS {
+					return tc.ret
+				}
+				// The size includes the NUL terminator.
+				required := uint32(len(tc.metadata) + 1)
+				if *bufferSize < required {
+					*bufferSize = required
+					return ERROR_INSUFFICIENT_SIZE
+				}
+				copy(unsafe.Slice(pgpuMetadata, *bufferSize), tc.metadata+"\x00")
+				return SUCCESS
+			}
+
+			metadata, ret := nvmlDevice{}.GetPgpuMetadataString()
+			require.Equal(t, tc.ret, ret)
+			require.Equal(t, tc.expectedMetadata, metadata)
+		})
+	}
+}
+
+func TestGetVgpuUtilization(t *testing.T) {
+	original := nvmlDeviceGetVgpuUtilizationStub
+	defer func() {
+		nvmlDeviceGetVgpuUtilizationStub = original
+	}()
+
+	samples := []VgpuInstanceUtilizationSample{
+		{VgpuInstance: 1, TimeStamp: 100, SmUtil: [8]byte{10}},
+		{VgpuInstance: 2, TimeStamp: 100, SmUtil: [8]byte{20}},
+		{VgpuInstance: 3, TimeStamp: 100, SmUtil: [8]byte{30}},
+	}
+
+	testCases := []struct {
+		description     string
+		samples         []VgpuInstanceUtilizationSample
+		ret             Return
+		expectedSamples []VgpuInstanceUtilizationSample
+		expectedRet     Return
+	}{
+		{
+			description:     "samples for all instances",
+			samples:         samples,
+			ret:             SUCCESS,
+			expectedSamples: samples,
+			expectedRet:     SUCCESS,
+		},
+		{
+			description:     "idle window",
+			ret:             ERROR_NOT_FOUND,
+			expectedSamples: []VgpuInstanceUtilizationSample{},
+			expectedRet:     SUCCESS,
+		},
+		{
+			description: "not supported",
+			ret:         ERROR_NOT_SUPPORTED,
+			expectedRet: ERROR_NOT_SUPPORTED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			nvmlDeviceGetVgpuUtilizationStub = func(device nvmlDevice, lastSeenTimestamp uint64, sampleValType *ValueType, vgpuInstanceSamplesCount *uint32, utilizationSamples *VgpuInstanceUtilizationSample) Return {
+				if tc.ret != SUCCESS {
+					return tc.ret
+				}
+				*sampleValType = VALUE_TYPE_UNSIGNED_INT
+				if int(*vgpuInstanceSamplesCount) < len(tc.samples) {
+					*vgpuInstanceSamplesCount = uint32(len(tc.samples))
+					return ERROR_INSUFFICIENT_SIZE
+				}
+				copy(unsafe.Slice(utilizationSamples, *vgpuInstanceSamplesCount), tc.samples)
+				*vgpuInstanceSamplesCount = uint32(len(tc.samples))
+				return SUCCESS
+			}
+
+			_, samples, ret := nvmlDevice{}.GetVgpuUtilization(0)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expectedSamples, samples)
+		})
+	}
+}
+
+func TestGetVgpuProcessUtilization(t *testing.T) {
+	original := nvmlDeviceGetVgpuProcessUtilizationStub
+	defer func() {
+		nvmlDeviceGetVgpuProcessUtilizationStub = original
+	}()
+
+	samples := []VgpuProcessUtilizationSample{
+		{VgpuInstance: 1, Pid: 100, TimeStamp: 100, SmUtil: 10},
+		{VgpuInstance: 1, Pid: 101, TimeStamp: 100, SmUtil: 20},
+	}
+
+	testCases := []struct {
+		description     string
+		samples         []VgpuProcessUtilizationSample
+		ret             Return
+		expectedSamples []VgpuProcessUtilizationSample
+	}{
+		{
+			description:     "active processes",
+			samples:         samples,
+			ret:             SUCCESS,
+			expectedSamples: samples,
+		},
+		{
+			description:     "empty window",
+			ret:             ERROR_NOT_FOUND,
+			expectedSamples: []VgpuProcessUtilizationSample{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			nvmlDeviceGetVgpuProcessUtilizationStub = func(device nvmlDevice, lastSeenTimestamp uint64, vgpuProcessSamplesCount *uint32, utilizationSamples *VgpuProcessUtilizationSample) Return {
+				if tc.ret != SUCCESS {
+					return tc.ret
+				}
+				if int(*vgpuProcessSamplesCount) < len(tc.samples) {
+					*vgpuProcessSamplesCount = uint32(len(tc.samples))
+					return ERROR_INSUFFICIENT_SIZE
+				}
+				copy(unsafe.Slice(utilizationSamples, *vgpuProcessSamplesCount), tc.samples)
+				*vgpuProcessSamplesCount = uint32(len(tc.samples))
+				return SUCCESS
+			}
+
+			samples, ret := nvmlDevice{}.GetVgpuProcessUtilization(0)
+			require.Equal(t, SUCCESS, ret)
+			require.Equal(t, tc.expectedSamples, samples)
+		})
+	}
+}
+
+func TestNvLinkUtilizationControl(t *testing.T) {
+	originalSet := nvmlDeviceSetNvLinkUtilizationControlStub
+	originalGet := nvmlDeviceGetNvLinkUtilizationControlStub
+	defer func() {
+		nvmlDeviceSetNvLinkUtilizationControlStub = originalSet
+		nvmlDeviceGetNvLinkUtilizationControlStub = originalGet
+	}()
+
+	const activeLink = 0
+	controls := make(map[uint32]NvLinkUtilizationControl)
+	var resets []uint32
+
+	nvmlDeviceSetNvLinkUtilizationControlStub = func(device nvmlDevice, link uint32, counter uint32, control *NvLinkUtilizationControl, reset uint32) Return {
+		if link != activeLink {
+			return ERROR_NOT_SUPPORTED
+		}
+		controls[counter] = *control
+		resets = append(resets, reset)
+		return SUCCESS
+	}
+	nvmlDeviceGetNvLinkUtilizationControlStub = func(device nvmlDevice, link uint32, counter uint32, control *NvLinkUtilizationControl) Return {
+		if link != activeLink {
+			return ERROR_NOT_SUPPORTED
+		}
+		*control = controls[counter]
+		return SUCCESS
+	}
+
+	control := NvLinkUtilizationControl{
+		Units:     uint32(NVLINK_COUNTER_UNIT_BYTES),
+		Pktfilter: uint32(NVLINK_COUNTER_PKTFILTER_READ | NVLINK_COUNTER_PKTFILTER_WRITE),
+	}
+
+	ret := nvmlDevice{}.SetNvLinkUtilizationControl(activeLink, 1, &control, true)
+	require.Equal(t, SUCCESS, ret)
+	ret = nvmlDevice{}.SetNvLinkUtilizationControl(activeLink, 1, &control, false)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, []uint32{1, 0}, resets)
+
+	got, ret := nvmlDevice{}.GetNvLinkUtilizationControl(activeLink, 1)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, control, got)
+	require.Equal(t, "NVLINK_COUNTER_UNIT_BYTES", NvLinkUtilizationCountUnits(got.Units).String())
+	require.Equal(t, "NVLINK_COUNTER_PKTFILTER_READ|NVLINK_COUNTER_PKTFILTER_WRITE", NvLinkUtilizationCountPktTypes(got.Pktfilter).String())
+
+	ret = nvmlDevice{}.SetNvLinkUtilizationControl(activeLink+1, 1, &control, false)
+	require.Equal(t, ERROR_NOT_SUPPORTED, ret)
+	_, ret = nvmlDevice{}.GetNvLinkUtilizationControl(activeLink+1, 1)
+	require.Equal(t, ERROR_NOT_SUPPORTED, ret)
+}
+
+func TestGetNvLinkRemoteDeviceType(t *testing.T) {
+	original := nvmlDeviceGetNvLinkRemoteDeviceTypeStub
+	defer func() {
+		nvmlDeviceGetNvLinkRemoteDeviceTypeStub = original
+	}()
+
+	remotes := map[uint32]IntNvLinkDeviceType{
+		0: NVLINK_DEVICE_TYPE_SWITCH,
+		1: NVLINK_DEVICE_TYPE_GPU,
+	}
+	nvmlDeviceGetNvLinkRemoteDeviceTypeStub = func(device nvmlDevice, link uint32, nvLinkDeviceType *IntNvLinkDeviceType) Return {
+		remote, ok := remotes[link]
+		if !ok {
+			return ERROR_NOT_SUPPORTED
+		}
+		*nvLinkDeviceType = remote
+		return SUCCESS
+	}
+
+	remote, ret := nvmlDevice{}.GetNvLinkRemoteDeviceType(0)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, NVLINK_DEVICE_TYPE_SWITCH, remote)
+
+	remote, ret = nvmlDevice{}.GetNvLinkRemoteDeviceType(1)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, NVLINK_DEVICE_TYPE_GPU, remote)
+
+	remote, ret = nvmlDevice{}.GetNvLinkRemoteDeviceType(2)
+	require.Equal(t, ERROR_NOT_SUPPORTED, ret)
+	require.Equal(t, NVLINK_DEVICE_TYPE_UNKNOWN, remote)
+}
+
+func TestGetClkVfOffset(t *testing.T) {
+	originalGpc := nvmlDeviceGetGpcClkVfOffsetStub
+	originalMem := nvmlDeviceGetMemClkVfOffsetStub
+	defer func() {
+		nvmlDeviceGetGpcClkVfOffsetStub = originalGpc
+		nvmlDeviceGetMemClkVfOffsetStub = originalMem
+	}()
+
+	nvmlDeviceGetGpcClkVfOffsetStub = func(device nvmlDevice, offset *int32) Return {
+		*offset = -100
+		return SUCCESS
+	}
+	nvmlDeviceGetMemClkVfOffsetStub = func(device nvmlDevice, offset *int32) Return {
+		*offset = 500
+		return SUCCESS
+	}
+
+	offset, ret := nvmlDevice{}.GetGpcClkVfOffset()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, -100, offset)
+
+	offset, ret = nvmlDevice{}.GetMemClkVfOffset()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, 500, offset)
+
+	nvmlDeviceGetMemClkVfOffsetStub = func(device nvmlDevice, offset *int32) Return {
+		return ERROR_NOT_SUPPORTED
+	}
+	_, ret = nvmlDevice{}.GetMemClkVfOffset()
+	require.Equal(t, ERROR_NOT_SUPPORTED, ret)
+}
+
+func TestGetClkMinMaxVfOffset(t *testing.T) {
+	originalGpc := nvmlDeviceGetGpcClkMinMaxVfOffsetStub
+	originalMem := nvmlDeviceGetMemClkMinMaxVfOffsetStub
+	defer func() {
+		nvmlDeviceGetGpcClkMinMaxVfOffsetStub = originalGpc
+		nvmlDeviceGetMemClkMinMaxVfOffsetStub = originalMem
+	}()
+
+	nvmlDeviceGetGpcClkMinMaxVfOffsetStub = func(device nvmlDevice, minOffset *int32, maxOffset *int32) Return {
+		*minOffset, *maxOffset = -200, 300
+		return SUCCESS
+	}
+	nvmlDeviceGetMemClkMinMaxVfOffsetStub = func(device nvmlDevice, minOffset *int32, maxOffset *int32) Return {
+		return ERROR_NOT_SUPPORTED
+	}
+
+	minOffset, maxOffset, ret := nvmlDevice{}.GetGpcClkMinMaxVfOffset()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, -200, minOffset)
+	require.Equal(t, 300, maxOffset)
+
+	_, _, ret = nvmlDevice{}.GetMemClkMinMaxVfOffset()
+	require.Equal(t, ERROR_NOT_SUPPORTED, ret)
+}
+
+func TestSetTemperatureThreshold(t *testing.T) {
+	original := nvmlDeviceSetTemperatureThresholdStub
+	defer func() {
+		nvmlDeviceSetTemperatureThresholdStub = original
+	}()
+
+	root := false
+	var acoustic int32
+	nvmlDeviceSetTemperatureThresholdStub = func(device nvmlDevice, thresholdType TemperatureThresholds, temp *int32) Return {
+		if thresholdType != TEMPERATURE_THRESHOLD_ACOUSTIC_CURR {
+			return ERROR_NOT_SUPPORTED
+		}
+		if !root {
+			return ERROR_NO_PERMISSION
+		}
+		acoustic = *temp
+		return SUCCESS
+	}
+
+	ret := nvmlDevice{}.SetTemperatureThreshold(TEMPERATURE_THRESHOLD_ACOUSTIC_CURR, 80)
+	require.Equal(t, ERROR_NO_PERMISSION, ret)
+	require.Equal(t, int32(0), acoustic)
+
+	root = true
+	ret = nvmlDevice{}.SetTemperatureThreshold(TEMPERATURE_THRESHOLD_ACOUSTIC_CURR, 80)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, int32(80), acoustic)
+
+	ret = nvmlDevice{}.SetTemperatureThreshold(TEMPERATURE_THRESHOLD_SHUTDOWN, 100)
+	require.Equal(t, ERROR_NOT_SUPPORTED, ret)
+}
+
+func TestGetThermalSettings(t *testing.T) {
+	original := nvmlDeviceGetThermalSettingsStub
+	defer func() {
+		nvmlDeviceGetThermalSettingsStub = original
+	}()
+
+	settings := GpuThermalSettings{
+		Count: 2,
+		Sensor: [3]GpuThermalSettingsSensor{
+			{
+				Controller:     int32(THERMAL_CONTROLLER_GPU_INTERNAL),
+				DefaultMinTemp: -40,
+				DefaultMaxTemp: 95,
+				CurrentTemp:    55,
+				Target:         int32(THERMAL_TARGET_GPU),
+			},
+			{
+				Controller:     int32(THERMAL_CONTROLLER_GPU_INTERNAL),
+				DefaultMinTemp: -40,
+				DefaultMaxTemp: 105,
+				CurrentTemp:    60,
+				Target:         int32(THERMAL_TARGET_MEMORY),
+			},
+		},
+	}
+	nvmlDeviceGetThermalSettingsStub = func(device nvmlDevice, sensorIndex uint32, thermalSettings *GpuThermalSettings) Return {
+		if sensorIndex != uint32(THERMAL_TARGET_ALL) {
+			return ERROR_NOT_SUPPORTED
+		}
+		*thermalSettings = settings
+		return SUCCESS
+	}
+
+	got, ret := nvmlDevice{}.GetThermalSettings(uint32(THERMAL_TARGET_ALL))
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, settings, got)
+	require.Equal(t, "THERMAL_TARGET_MEMORY", ThermalTarget(got.Sensor[1].Target).String())
+	require.Equal(t, "THERMAL_CONTROLLER_GPU_INTERNAL", ThermalController(got.Sensor[1].Controller).String())
+
+	_, ret = nvmlDevice{}.GetThermalSettings(0)
+	require.Equal(t, ERROR_NOT_SUPPORTED, ret)
+}
+
+func TestGetMinMaxFanSpeed(t *testing.T) {
+	original := nvmlDeviceGetMinMaxFanSpeedStub
+	defer func() {
+		nvmlDeviceGetMinMaxFanSpeedStub = original
+	}()
+
+	nvmlDeviceGetMinMaxFanSpeedStub = func(device nvmlDevice, minSpeed *uint32, maxSpeed *uint32) Return {
+		*minSpeed, *maxSpeed = 30, 100
+		return SUCCESS
+	}
+	minSpeed, maxSpeed, ret := nvmlDevice{}.GetMinMaxFanSpeed()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, 30, minSpeed)
+	require.Equal(t, 100, maxSpeed)
+
+	nvmlDeviceGetMinMaxFanSpeedStub = func(device nvmlDevice, minSpeed *uint32, maxSpeed *uint32) Return {
+		return ERROR_NOT_SUPPORTED
+	}
+	_, _, ret = nvmlDevice{}.GetMinMaxFanSpeed()
+	require.Equal(t, ERROR_NOT_SUPPORTED, ret)
+}
+
+func TestGetConfComputeMemSizeInfo(t *testing.T) {
+	original := nvmlDeviceGetConfComputeMemSizeInfoStub
+	defer func() {
+		nvmlDeviceGetConfComputeMemSizeInfoStub = original
+	}()
+
+	nvmlDeviceGetConfComputeMemSizeInfoStub = func(device nvmlDevice, memInfo *ConfComputeMemSizeInfo) Return {
+		memInfo.ProtectedMemSizeKib = 78 << 20
+		memInfo.UnprotectedMemSizeKib = 2 << 20
+		return SUCCESS
+	}
+	memInfo, ret := nvmlDevice{}.GetConfComputeMemSizeInfo()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, ConfComputeMemSizeInfo{ProtectedMemSizeKib: 78 << 20, UnprotectedMemSizeKib: 2 << 20}, memInfo)
+
+	nvmlDeviceGetConfComputeMemSizeInfoStub = func(device nvmlDevice, memInfo *ConfComputeMemSizeInfo) Return {
+		return ERROR_NOT_SUPPORTED
+	}
+	_, ret = nvmlDevice{}.GetConfComputeMemSizeInfo()
+	require.Equal(t, ERROR_NOT_SUPPORTED, ret)
+}
+
+func TestGpuOperationMode(t *testing.T) {
+	originalGet := nvmlDeviceGetGpuOperationModeStub
+	originalSet := nvmlDeviceSetGpuOperationModeStub
+	defer func() {
+		nvmlDeviceGetGpuOperationModeStub = originalGet
+		nvmlDeviceSetGpuOperationModeStub = originalSet
+	}()
+
+	current, pending := GOM_ALL_ON, GOM_ALL_ON
+	nvmlDeviceGetGpuOperationModeStub = func(device nvmlDevice, currentMode *GpuOperationMode, pendingMode *GpuOperationMode) Return {
+		*currentMode, *pendingMode = current, pending
+		return SUCCESS
+	}
+	nvmlDeviceSetGpuOperationModeStub = func(device nvmlDevice, mode GpuOperationMode) Return {
+		pending = mode
+		return SUCCESS
+	}
+
+	ret := nvmlDevice{}.SetGpuOperationMode(GOM_COMPUTE)
+	require.Equal(t, SUCCESS, ret)
+
+	gotCurrent, gotPending, ret := nvmlDevice{}.GetGpuOperationMode()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, GOM_ALL_ON, gotCurrent)
+	require.Equal(t, GOM_COMPUTE, gotPending)
+
+	nvmlDeviceGetGpuOperationModeStub = func(device nvmlDevice, currentMode *GpuOperationMode, pendingMode *GpuOperationMode) Return {
+		return ERROR_NOT_SUPPORTED
+	}
+	_, _, ret = nvmlDevice{}.GetGpuOperationMode()
+	require.Equal(t, ERROR_NOT_SUPPORTED, ret)
+}
+
+func TestGetName(t *testing.T) {
+	original := nvmlDeviceGetNameStub
+	defer func() {
+		nvmlDeviceGetNameStub = original
+	}()
+
+	testCases := []struct {
+		description  string
+		buffer       string
+		expectedName string
+	}{
+		{"unpadded", "NVIDIA H100 80GB HBM3", "NVIDIA H100 80GB HBM3"},
+		{"NUL padded", "NVIDIA H100 80GB HBM3\x00\x00\x00", "NVIDIA H100 80GB HBM3"},
+		{"whitespace padded", "NVIDIA H100 80GB HBM3   \x00", "NVIDIA H100 80GB HBM3"},
+		{"whitespace and garbage after NUL", "NVIDIA A100\t \x00junk", "NVIDIA A100"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			nvmlDeviceGetNameStub = func(device nvmlDevice, name *byte, length uint32) Return {
+				copy(unsafe.Slice(name, length), tc.buffer)
+				return SUCCESS
+			}
+
+			name, ret := nvmlDevice{}.GetName()
+			require.Equal(t, SUCCESS, ret)
+			require.Equal(t, tc.expectedName, name)
+		})
+	}
+}
+
+func TestGetBusType(t *testing.T) {
+	original := nvmlDeviceGetBusTypeStub
+	defer func() {
+		nvmlDeviceGetBusTypeStub = original
+	}()
+
+	nvmlDeviceGetBusTypeStub = func(device nvmlDevice, busType *BusType) Return {
+		*busType = BUS_TYPE_PCIE
+		return SUCCESS
+	}
+	busType, ret := nvmlDevice{}.GetBusType()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, BusType(BUS_TYPE_PCIE), busType)
+	require.Equal(t, "BUS_TYPE_PCIE", busType.String())
+
+	nvmlDeviceGetBusTypeStub = func(device nvmlDevice, busType *BusType) Return {
+		*busType = 0xdead
+		return ERROR_UNKNOWN
+	}
+	busType, ret = nvmlDevice{}.GetBusType()
+	require.Equal(t, ERROR_UNKNOWN, ret)
+	require.Equal(t, BusType(BUS_TYPE_UNKNOWN), busType)
+}
+
+func TestGetMemoryBusWidthAndPcieSpeed(t *testing.T) {
+	originalBusWidth := nvmlDeviceGetMemoryBusWidthStub
+	originalPcieSpeed := nvmlDeviceGetPcieSpeedStub
+	defer func() {
+		nvmlDeviceGetMemoryBusWidthStub = originalBusWidth
+		nvmlDeviceGetPcieSpeedStub = originalPcieSpeed
+	}()
+
+	nvmlDeviceGetMemoryBusWidthStub = func(device nvmlDevice, busWidth *uint32) Return {
+		*busWidth = 5120
+		return SUCCESS
+	}
+	nvmlDeviceGetPcieSpeedStub = func(device nvmlDevice, pcieSpeed *uint32) Return {
+		*pcieSpeed = 32000
+		return SUCCESS
+	}
+
+	busWidth, ret := nvmlDevice{}.GetMemoryBusWidth()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, uint32(5120), busWidth)
+
+	pcieSpeed, ret := nvmlDevice{}.GetPcieSpeed()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, 32000, pcieSpeed)
+
+	nvmlDeviceGetMemoryBusWidthStub = func(device nvmlDevice, busWidth *uint32) Return {
+		return ERROR_NOT_SUPPORTED
+	}
+	nvmlDeviceGetPcieSpeedStub = func(device nvmlDevice, pcieSpeed *uint32) Return {
+		return ERROR_NOT_SUPPORTED
+	}
+
+	_, ret = nvmlDevice{}.GetMemoryBusWidth()
+	require.Equal(t, ERROR_NOT_SUPPORTED, ret)
+	_, ret = nvmlDevice{}.GetPcieSpeed()
+	require.Equal(t, ERROR_NOT_SUPPORTED, ret)
+}
+
+func TestClearFieldValuesEmpty(t *testing.T) {
+	original := deviceClearFieldValues
+	defer func() {
+		deviceClearFieldValues = original
+	}()
+
+	deviceClearFieldValues = func(device nvmlDevice, valuesCount int32, values *FieldValue) Return {
+		t.Fatal("unexpected call to nvmlDeviceClearFieldValues")
+		return ERROR_UNKNOWN
+	}
+	require.Equal(t, SUCCESS, nvmlDevice{}.ClearFieldValues(nil))
+}
+
+func TestGetFieldValuesEmpty(t *testing.T) {
+	original := deviceGetFieldValues
+	defer func() {
+		deviceGetFieldValues = original
+	}()
+
+	deviceGetFieldValues = func(device nvmlDevice, valuesCount int32, values *FieldValue) Return {
+		t.Fatal("unexpected call to nvmlDeviceGetFieldValues")
+		return ERROR_UNKNOWN
+	}
+	require.Equal(t, SUCCESS, nvmlDevice{}.GetFieldValues(nil))
+}
+
+func TestGetClock(t *testing.T) {
+	original := nvmlDeviceGetClockStub
+	defer func() {
+		nvmlDeviceGetClockStub = original
+	}()
+
+	nvmlDeviceGetClockStub = func(device nvmlDevice, clockType ClockType, clockId ClockId, clockMHz *uint32) Return {
+		switch {
+		case clockType == CLOCK_GRAPHICS && clockId == CLOCK_ID_CUSTOMER_BOOST_MAX:
+			*clockMHz = 1980
+		case clockType == CLOCK_MEM && clockId == CLOCK_ID_CURRENT:
+			*clockMHz = 2619
+		default:
+			return ERROR_NOT_SUPPORTED
+		}
+		return SUCCESS
+	}
+
+	clock, ret := nvmlDevice{}.GetClock(CLOCK_GRAPHICS, CLOCK_ID_CUSTOMER_BOOST_MAX)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, uint32(1980), clock)
+
+	clock, ret = nvmlDevice{}.GetClock(CLOCK_MEM, CLOCK_ID_CURRENT)
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, uint32(2619), clock)
+
+	_, ret = nvmlDevice{}.GetClock(CLOCK_VIDEO, CLOCK_ID_CUSTOMER_BOOST_MAX)
+	require.Equal(t, ERROR_NOT_SUPPORTED, ret)
+}
+
+func TestGetComputeInstanceProfileInfoV(t *testing.T) {
+	originalV := nvmlGpuInstanceGetComputeInstanceProfileInfoVStub
+	original := nvmlGpuInstanceGetComputeInstanceProfileInfoStub
+	defer func() {
+		nvmlGpuInstanceGetComputeInstanceProfileInfoVStub = originalV
+		nvmlGpuInstanceGetComputeInstanceProfileInfoStub = original
+	}()
+
+	nvmlGpuInstanceGetComputeInstanceProfileInfoStub = func(gpuInstance nvmlGpuInstance, profile uint32, engProfile uint32, info *ComputeInstanceProfileInfo) Return {
+		*info = ComputeInstanceProfileInfo{
+			Id:                    profile,
+			SliceCount:            2,
+			InstanceCount:         3,
+			MultiprocessorCount:   28,
+			SharedCopyEngineCount: 1,
+			SharedDecoderCount:    1,
+		}
+		return SUCCESS
+	}
+
+	testCases := []struct {
+		description  string
+		versionedRet Return
+		expectedInfo ComputeInstanceProfileInfo_v2
+		expectedRet  Return
+	}{
+		{
+			description: "versioned call supported",
+			expectedInfo: ComputeInstanceProfileInfo_v2{
+				Version:             STRUCT_VERSION(ComputeInstanceProfileInfo_v2{}, 2),
+				Id:                  COMPUTE_INSTANCE_PROFILE_2_SLICE,
+				SliceCount:          2,
+				MultiprocessorCount: 28,
+				Name:                [96]int8{'2', 'c'},
+			},
+			expectedRet: SUCCESS,
+		},
+		{
+			description:  "versioned symbol missing",
+			versionedRet: ERROR_FUNCTION_NOT_FOUND,
+			expectedInfo: ComputeInstanceProfileInfo_v2{
+				Id:                    COMPUTE_INSTANCE_PROFILE_2_SLICE,
+				SliceCount:            2,
+				InstanceCount:         3,
+				MultiprocessorCount:   28,
+				SharedCopyEngineCount: 1,
+				SharedDecoderCount:    1,
+			},
+			expectedRet: SUCCESS,
+		},
+		{
+			description:  "versioned call fails",
+			versionedRet: ERROR_INVALID_ARGUMENT,
+			expectedInfo: ComputeInstanceProfileInfo_v2{
+				Version: STRUCT_VERSION(ComputeInstanceProfileInfo_v2{}, 2),
+			},
+			expectedRet: ERROR_INVALID_ARGUMENT,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			nvmlGpuInstanceGetComputeInstanceProfileInfoVStub = func(gpuInstance nvmlGpuInstance, profile uint32, engProfile uint32, info *ComputeInstanceProfileInfo_v2) Return {
+				if tc.versionedRet != SUCCESS {
+					return tc.versionedRet
+				}
+				info.Id = profile
+				info.SliceCount = 2
+				info.MultiprocessorCount = 28
+				info.Name = [96]int8{'2', 'c'}
+				return SUCCESS
+			}
+
+			info, ret := nvmlGpuInstance{}.GetComputeInstanceProfileInfoV(COMPUTE_INSTANCE_PROFILE_2_SLICE, COMPUTE_INSTANCE_ENGINE_PROFILE_SHARED).V2()
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expectedInfo, info)
+		})
+	}
+}
+
+func TestGetInstanceById(t *testing.T) {
+	originalGpuInstance := nvmlDeviceGetGpuInstanceByIdStub
+	originalComputeInstance := nvmlGpuInstanceGetComputeInstanceByIdStub
+	defer func() {
+		nvmlDeviceGetGpuInstanceByIdStub = originalGpuInstance
+		nvmlGpuInstanceGetComputeInstanceByIdStub = originalComputeInstance
+	}()
+
+	var requestedIds []uint32
+	nvmlDeviceGetGpuInstanceByIdStub = func(device nvmlDevice, id uint32, gpuInstance *nvmlGpuInstance) Return {
+		requestedIds = append(requestedIds, id)
+		if id != 1 {
+			return ERROR_NOT_FOUND
+		}
+		return SUCCESS
+	}
+	nvmlGpuInstanceGetComputeInstanceByIdStub = func(gpuInstance nvmlGpuInstance, id uint32, computeInstance *nvmlComputeInstance) Return {
+		requestedIds = append(requestedIds, id)
+		if id != 0 {
+			return ERROR_NOT_FOUND
+		}
+		return SUCCESS
+	}
+
+	gpuInstance, ret := libnvml.DeviceGetGpuInstanceById(nvmlDevice{}, 1)
+	require.Equal(t, SUCCESS, ret)
+	require.IsType(t, nvmlGpuInstance{}, gpuInstance)
+
+	computeInstance, ret := gpuInstance.GetComputeInstanceById(0)
+	require.Equal(t, SUCCESS, ret)
+	require.IsType(t, nvmlComputeInstance{}, computeInstance)
+
+	_, ret = nvmlDevice{}.GetGpuInstanceById(2)
+	require.Equal(t, ERROR_NOT_FOUND, ret)
+
+	_, ret = gpuInstance.GetComputeInstanceById(1)
+	require.Equal(t, ERROR_NOT_FOUND, ret)
+
+	require.Equal(t, []uint32{1, 0, 2, 1}, requestedIds)
+}
+
+func TestDriverModel(t *testing.T) {
+	originalGet := nvmlDeviceGetDriverModelStub
+	originalSet := nvmlDeviceSetDriverModelStub
+	defer func() {
+		nvmlDeviceGetDriverModelStub = originalGet
+		nvmlDeviceSetDriverModelStub = originalSet
+	}()
+
+	testCases := []struct {
+		description     string
+		ret             Return
+		expectedCurrent DriverModel
+		expectedPending DriverModel
+	}{
+		{
+			description:     "windows",
+			ret:             SUCCESS,
+			expectedCurrent: DRIVER_WDDM,
+			expectedPending: DRIVER_WDM,
+		},
+		{
+			description: "linux",
+			ret:         ERROR_NOT_SUPPORTED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			pendingModel := DRIVER_WDDM
+			nvmlDeviceGetDriverModelStub = func(device nvmlDevice, current *DriverModel, p *DriverModel) Return {
+				if tc.ret != SUCCESS {
+					return tc.ret
+				}
+				*current = DRIVER_WDDM
+				*p = pendingModel
+				return SUCCESS
+			}
+			nvmlDeviceSetDriverModelStub = func(device nvmlDevice, driverModel DriverModel, flags uint32) Return {
+				if tc.ret != SUCCESS {
+					return tc.ret
+				}
+				pendingModel = driverModel
+				return SUCCESS
+			}
+
+			ret := libnvml.DeviceSetDriverModel(nvmlDevice{}, DRIVER_WDM, 0)
+			require.Equal(t, tc.ret, ret)
+
+			current, pending, ret := nvmlDevice{}.GetDriverModel()
+			require.Equal(t, tc.ret, ret)
+			require.Equal(t, tc.expectedCurrent, current)
+			require.Equal(t, tc.expectedPending, pending)
+		})
+	}
+}
+
+func TestDisplay(t *testing.T) {
+	originalMode := nvmlDeviceGetDisplayModeStub
+	originalActive := nvmlDeviceGetDisplayActiveStub
+	defer func() {
+		nvmlDeviceGetDisplayModeStub = originalMode
+		nvmlDeviceGetDisplayActiveStub = originalActive
+	}()
+
+	testCases := []struct {
+		description    string
+		mode           EnableState
+		active         EnableState
+		ret            Return
+		expectedMode   bool
+		expectedActive bool
+		expectedRet    Return
+	}{
+		{
+			description: "headless",
+			mode:        FEATURE_DISABLED,
+			active:      FEATURE_DISABLED,
+			expectedRet: SUCCESS,
+		},
+		{
+			description:    "display connected",
+			mode:           FEATURE_ENABLED,
+			active:         FEATURE_ENABLED,
+			expectedMode:   true,
+			expectedActive: true,
+			expectedRet:    SUCCESS,
+		},
+		{
+			description:    "virtual display",
+			mode:           FEATURE_DISABLED,
+			active:         FEATURE_ENABLED,
+			expectedActive: true,
+			expectedRet:    SUCCESS,
+		},
+		{
+			description: "not supported",
+			ret:         ERROR_NOT_SUPPORTED,
+			expectedRet: ERROR_NOT_SUPPORTED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			nvmlDeviceGetDisplayModeStub = func(device nvmlDevice, display *EnableState) Return {
+				*display = tc.mode
+				return tc.ret
+			}
+			nvmlDeviceGetDisplayActiveStub = func(device nvmlDevice, isActive *EnableState) Return {
+				*isActive = tc.active
+				return tc.ret
+			}
+
+			mode, ret := libnvml.DeviceGetDisplayMode(nvmlDevice{})
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expectedMode, mode.Bool())
+
+			active, ret := nvmlDevice{}.GetDisplayActive()
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expectedActive, active.Bool())
+		})
+	}
+}
+
+func TestGetJpgAndOfaUtilization(t *testing.T) {
+	originalJpg := nvmlDeviceGetJpgUtilizationStub
+	originalOfa := nvmlDeviceGetOfaUtilizationStub
+	defer func() {
+		nvmlDeviceGetJpgUtilizationStub = originalJpg
+		nvmlDeviceGetOfaUtilizationStub = originalOfa
+	}()
+
+	testCases := []struct {
+		description              string
+		ret                      Return
+		expectedUtilization      uint32
+		expectedSamplingPeriodUs uint32
+	}{
+		{
+			description:              "steady utilization",
+			ret:                      SUCCESS,
+			expectedUtilization:      42,
+			expectedSamplingPeriodUs: 166666,
+		},
+		{
+			description: "older card",
+			ret:         ERROR_NOT_SUPPORTED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			stub := func(device nvmlDevice, utilization *uint32, samplingPeriodUs *uint32) Return {
+				if tc.ret != SUCCESS {
+					return tc.ret
+				}
+				*utilization = 42
+				*samplingPeriodUs = 166666
+				return SUCCESS
+			}
+			nvmlDeviceGetJpgUtilizationStub = stub
+			nvmlDeviceGetOfaUtilizationStub = stub
+
+			for _, get := range []func() (uint32, uint32, Return){
+				nvmlDevice{}.GetJpgUtilization,
+				nvmlDevice{}.GetOfaUtilization,
+			} {
+				// Repeated reads of a steady engine return the same value.
+				for i := 0; i < 2; i++ {
+					utilization, samplingPeriodUs, ret := get()
+					require.Equal(t, tc.ret, ret)
+					require.Equal(t, tc.expectedUtilization, utilization)
+					require.Equal(t, tc.expectedSamplingPeriodUs, samplingPeriodUs)
+				}
+			}
+		})
+	}
+}
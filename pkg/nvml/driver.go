@@ -0,0 +1,55 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+// DriverProbe describes the installed driver, as reported by NVML without
+// attaching to any GPUs.
+type DriverProbe struct {
+	DriverVersion string
+	NVMLVersion   string
+	// DeviceCount is the number of GPUs visible to NVML, which may be zero.
+	DeviceCount int
+}
+
+// ProbeDriver initializes NVML with INIT_FLAG_NO_GPUS and INIT_FLAG_NO_ATTACH,
+// reads the driver and NVML versions along with the device count, and shuts
+// NVML down again. Since no GPUs are attached, this succeeds on systems
+// without GPUs or with GPUs that cannot be attached, which allows an
+// installer to verify the driver before using any GPU. If a query fails, the
+// fields read so far are returned along with its error.
+func ProbeDriver(lib Interface) (DriverProbe, Return) {
+	var probe DriverProbe
+
+	ret := lib.InitWithFlags(INIT_FLAG_NO_GPUS | INIT_FLAG_NO_ATTACH)
+	if ret != SUCCESS {
+		return probe, ret
+	}
+	defer func() {
+		_ = lib.Shutdown()
+	}()
+
+	if probe.DriverVersion, ret = lib.SystemGetDriverVersion(); ret != SUCCESS {
+		return probe, ret
+	}
+	if probe.NVMLVersion, ret = lib.SystemGetNVMLVersion(); ret != SUCCESS {
+		return probe, ret
+	}
+	if probe.DeviceCount, ret = lib.DeviceGetCount(); ret != SUCCESS {
+		return probe, ret
+	}
+	return probe, SUCCESS
+}
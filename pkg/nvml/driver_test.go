@@ -0,0 +1,98 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestProbeDriver(t *testing.T) {
+	testCases := []struct {
+		description   string
+		driverRet     nvml.Return
+		expectedProbe nvml.DriverProbe
+		expectedRet   nvml.Return
+	}{
+		{
+			description: "no GPUs",
+			expectedProbe: nvml.DriverProbe{
+				DriverVersion: "550.54.15",
+				NVMLVersion:   "12.550.54.15",
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "driver version fails",
+			driverRet:   nvml.ERROR_UNKNOWN,
+			expectedRet: nvml.ERROR_UNKNOWN,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			lib := &mock.Interface{
+				InitFunc: func() nvml.Return {
+					return nvml.ERROR_NOT_FOUND
+				},
+				InitWithFlagsFunc: func(flags uint32) nvml.Return {
+					if flags&nvml.INIT_FLAG_NO_ATTACH == 0 {
+						return nvml.ERROR_NOT_FOUND
+					}
+					return nvml.SUCCESS
+				},
+				ShutdownFunc: func() nvml.Return {
+					return nvml.SUCCESS
+				},
+				SystemGetDriverVersionFunc: func() (string, nvml.Return) {
+					if tc.driverRet != nvml.SUCCESS {
+						return "", tc.driverRet
+					}
+					return "550.54.15", nvml.SUCCESS
+				},
+				SystemGetNVMLVersionFunc: func() (string, nvml.Return) {
+					return "12.550.54.15", nvml.SUCCESS
+				},
+				DeviceGetCountFunc: func() (int, nvml.Return) {
+					return 0, nvml.SUCCESS
+				},
+			}
+
+			probe, ret := nvml.ProbeDriver(lib)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expectedProbe, probe)
+			require.Empty(t, lib.InitCalls())
+			require.Len(t, lib.ShutdownCalls(), 1)
+		})
+	}
+}
+
+func TestProbeDriverInitFails(t *testing.T) {
+	lib := &mock.Interface{
+		InitWithFlagsFunc: func(flags uint32) nvml.Return {
+			return nvml.ERROR_DRIVER_NOT_LOADED
+		},
+	}
+
+	_, ret := nvml.ProbeDriver(lib)
+	require.Equal(t, nvml.ERROR_DRIVER_NOT_LOADED, ret)
+	require.Empty(t, lib.ShutdownCalls())
+}
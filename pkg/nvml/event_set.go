@@ -14,6 +14,11 @@
 
 package nvml
 
+import (
+	"fmt"
+	"strings"
+)
+
 // EventData includes an interface type for Device instead of nvmlDevice
 type EventData struct {
 	Device            Device
@@ -45,6 +50,81 @@ func (e nvmlEventData) convert() EventData {
 	return out
 }
 
+// eventTypes lists the event types that can be reported in
+// EventData.EventType.
+var eventTypes = []EventType{
+	EventTypeSingleBitEccError,
+	EventTypeDoubleBitEccError,
+	EventTypePState,
+	EventTypeXidCriticalError,
+	EventTypeClock,
+	EventTypePowerSourceChange,
+	EventMigConfigChange,
+}
+
+// EventTypes decodes the EventType mask of an event into the individual
+// event types set in it. Bits that do not correspond to a known event type are
+// ignored.
+func (e EventData) EventTypes() []EventType {
+	types := []EventType{}
+	for _, t := range eventTypes {
+		if e.EventType&uint64(t) != 0 {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+// TypedEventData is an EventData together with its decoded event types.
+type TypedEventData struct {
+	EventData
+	EventTypes []EventType
+}
+
+// WaitTyped waits for an event on an event set as EventSet.Wait does, and
+// also decodes the types of the event that occurred. When several devices are
+// registered on the set, the Device field holds the handle of the device on
+// which the event occurred, which can be used for further queries.
+func WaitTyped(set EventSet, timeoutms uint32) (TypedEventData, Return) {
+	data, ret := set.Wait(timeoutms)
+	if ret != SUCCESS {
+		return TypedEventData{}, ret
+	}
+	return TypedEventData{
+		EventData:  data,
+		EventTypes: data.EventTypes(),
+	}, SUCCESS
+}
+
+// RegisterEventsChecked registers a device for the specified event types on
+// an event set after validating that all of them are reported by
+// GetSupportedEventTypes. Some drivers accept the registration of unsupported
+// event types, which then never fire. If any requested type is not supported,
+// an error wrapping ERROR_NOT_SUPPORTED that lists the unsupported types is
+// returned without calling RegisterEvents. Any other failure is returned as a
+// Return.
+func RegisterEventsChecked(device Device, eventTypes uint64, set EventSet) error {
+	supported, ret := device.GetSupportedEventTypes()
+	if ret != SUCCESS {
+		return ret
+	}
+
+	if unsupported := eventTypes &^ supported; unsupported != 0 {
+		var names []string
+		for bit := uint64(1); bit != 0; bit <<= 1 {
+			if unsupported&bit != 0 {
+				names = append(names, EventType(bit).String())
+			}
+		}
+		return fmt.Errorf("event types 0x%x are not supported (%s): %w", unsupported, strings.Join(names, ", "), ERROR_NOT_SUPPORTED)
+	}
+
+	if ret := device.RegisterEvents(eventTypes, set); ret != SUCCESS {
+		return ret
+	}
+	return nil
+}
+
 // nvml.EventSetCreate()
 func (l *library) EventSetCreate() (EventSet, Return) {
 	var Set nvmlEventSet
@@ -0,0 +1,119 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestWaitTyped(t *testing.T) {
+	devices := []*mock.Device{
+		{GetIndexFunc: func() (int, nvml.Return) { return 0, nvml.SUCCESS }},
+		{GetIndexFunc: func() (int, nvml.Return) { return 1, nvml.SUCCESS }},
+	}
+	set := &mock.EventSet{
+		WaitFunc: func(timeoutms uint32) (nvml.EventData, nvml.Return) {
+			return nvml.EventData{
+				Device:    devices[1],
+				EventType: nvml.EventTypeXidCriticalError | nvml.EventTypeClock,
+				EventData: 79,
+			}, nvml.SUCCESS
+		},
+	}
+
+	data, ret := nvml.WaitTyped(set, 100)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []nvml.EventType{nvml.EventTypeXidCriticalError, nvml.EventTypeClock}, data.EventTypes)
+	require.Equal(t, uint64(79), data.EventData.EventData)
+
+	index, ret := data.Device.GetIndex()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, 1, index)
+
+	require.Len(t, set.WaitCalls(), 1)
+	require.Equal(t, uint32(100), set.WaitCalls()[0].V)
+}
+
+func TestWaitTypedTimeout(t *testing.T) {
+	set := &mock.EventSet{
+		WaitFunc: func(timeoutms uint32) (nvml.EventData, nvml.Return) {
+			return nvml.EventData{}, nvml.ERROR_TIMEOUT
+		},
+	}
+
+	data, ret := nvml.WaitTyped(set, 0)
+	require.Equal(t, nvml.ERROR_TIMEOUT, ret)
+	require.Empty(t, data.EventTypes)
+}
+
+func TestRegisterEventsChecked(t *testing.T) {
+	testCases := []struct {
+		description      string
+		eventTypes       uint64
+		expectedErr      error
+		expectedMessage  string
+		expectedRegister bool
+	}{
+		{
+			description:      "supported event types",
+			eventTypes:       nvml.EventTypeXidCriticalError | nvml.EventTypeDoubleBitEccError,
+			expectedRegister: true,
+		},
+		{
+			description:     "unsupported event type",
+			eventTypes:      nvml.EventTypeXidCriticalError | nvml.EventTypeClock,
+			expectedErr:     nvml.ERROR_NOT_SUPPORTED,
+			expectedMessage: "event types 0x10 are not supported (EventTypeClock)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			set := &mock.EventSet{}
+			device := &mock.Device{
+				GetSupportedEventTypesFunc: func() (uint64, nvml.Return) {
+					return nvml.EventTypeXidCriticalError | nvml.EventTypeDoubleBitEccError | nvml.EventTypeSingleBitEccError, nvml.SUCCESS
+				},
+				RegisterEventsFunc: func(eventTypes uint64, s nvml.EventSet) nvml.Return {
+					return nvml.SUCCESS
+				},
+			}
+
+			err := nvml.RegisterEventsChecked(device, tc.eventTypes, set)
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Contains(t, err.Error(), tc.expectedMessage)
+			}
+
+			calls := device.RegisterEventsCalls()
+			if !tc.expectedRegister {
+				require.Empty(t, calls)
+				return
+			}
+			require.Len(t, calls, 1)
+			require.Equal(t, tc.eventTypes, calls[0].V)
+			require.Equal(t, set, calls[0].EventSet)
+		})
+	}
+}
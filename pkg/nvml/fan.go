@@ -0,0 +1,36 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+// GetAllFanSpeeds returns the intended speed of each fan of a device as a
+// percentage of its maximum speed, indexed by fan. Devices without fans return
+// ERROR_NOT_SUPPORTED.
+func GetAllFanSpeeds(device Device) ([]uint32, Return) {
+	numFans, ret := device.GetNumFans()
+	if ret != SUCCESS {
+		return nil, ret
+	}
+
+	speeds := make([]uint32, numFans)
+	for fan := range speeds {
+		speeds[fan], ret = device.GetFanSpeed_v2(fan)
+		if ret != SUCCESS {
+			return nil, ret
+		}
+	}
+	return speeds, SUCCESS
+}
@@ -0,0 +1,56 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestGetAllFanSpeeds(t *testing.T) {
+	fanSpeeds := []uint32{40, 45, 50}
+	device := &mock.Device{
+		GetNumFansFunc: func() (int, nvml.Return) {
+			return len(fanSpeeds), nvml.SUCCESS
+		},
+		GetFanSpeed_v2Func: func(fan int) (uint32, nvml.Return) {
+			if fan < 0 || fan >= len(fanSpeeds) {
+				return 0, nvml.ERROR_INVALID_ARGUMENT
+			}
+			return fanSpeeds[fan], nvml.SUCCESS
+		},
+	}
+
+	speeds, ret := nvml.GetAllFanSpeeds(device)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, fanSpeeds, speeds)
+	require.Len(t, device.GetFanSpeed_v2Calls(), len(fanSpeeds))
+
+	_, ret = device.GetFanSpeed_v2(len(fanSpeeds))
+	require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, ret)
+
+	device.GetNumFansFunc = func() (int, nvml.Return) {
+		return 0, nvml.ERROR_NOT_SUPPORTED
+	}
+	speeds, ret = nvml.GetAllFanSpeeds(device)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+	require.Nil(t, speeds)
+}
@@ -0,0 +1,106 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"sync"
+)
+
+// ClearFieldValuesByID clears the accumulated values of the fields with the
+// specified ids (e.g. FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_TOTAL) on a device,
+// taking the same ids as WatchFieldValues. The returned map holds the result
+// for each field; fields that cannot be cleared carry ERROR_NOT_SUPPORTED. If
+// the call as a whole fails, its error is returned and the map is nil.
+func ClearFieldValuesByID(d Device, ids []uint32) (map[uint32]Return, Return) {
+	values := make([]FieldValue, len(ids))
+	for i, id := range ids {
+		values[i].FieldId = id
+	}
+
+	ret := d.ClearFieldValues(values)
+	if ret != SUCCESS {
+		return nil, ret
+	}
+
+	results := make(map[uint32]Return, len(values))
+	for _, value := range values {
+		results[value.FieldId] = Return(value.NvmlReturn)
+	}
+	return results, SUCCESS
+}
+
+// FieldValuesAllDevices reads the field values with the specified ids from
+// every device, using up to workers concurrent queries. The values of each
+// device are keyed by device index and field id, as returned by
+// WatchFieldValues. A device whose handle cannot be obtained, or whose query
+// fails as a whole, is recorded in the returned errors map under its index
+// instead of failing the whole operation; devices with a failed query still
+// have their fields listed, each carrying the error. Only a failure to count
+// the devices is returned as an error. With no ids there is nothing to read
+// and empty maps are returned without querying any device.
+func FieldValuesAllDevices(lib Interface, ids []uint32, workers int) (map[int]map[uint32]FieldValue, map[int]Return, Return) {
+	if len(ids) == 0 {
+		return map[int]map[uint32]FieldValue{}, map[int]Return{}, SUCCESS
+	}
+
+	count, ret := lib.DeviceGetCount()
+	if ret != SUCCESS {
+		return nil, nil, ret
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		values = make(map[int]map[uint32]FieldValue, count)
+		errors = make(map[int]Return)
+	)
+
+	indices := make(chan int)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indices {
+				var set map[uint32]FieldValue
+				device, ret := lib.DeviceGetHandleByIndex(index)
+				if ret == SUCCESS {
+					set, ret = readFieldValues(device, ids)
+				}
+
+				mu.Lock()
+				if set != nil {
+					values[index] = set
+				}
+				if ret != SUCCESS {
+					errors[index] = ret
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for index := 0; index < count; index++ {
+		indices <- index
+	}
+	close(indices)
+	wg.Wait()
+
+	return values, errors, SUCCESS
+}
@@ -0,0 +1,134 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestClearFieldValuesByID(t *testing.T) {
+	device := &mock.Device{
+		ClearFieldValuesFunc: func(values []nvml.FieldValue) nvml.Return {
+			for i := range values {
+				if values[i].FieldId != nvml.FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_TOTAL {
+					values[i].NvmlReturn = uint32(nvml.ERROR_NOT_SUPPORTED)
+				}
+			}
+			return nvml.SUCCESS
+		},
+	}
+
+	results, ret := nvml.ClearFieldValuesByID(device, []uint32{
+		nvml.FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_TOTAL,
+		nvml.FI_DEV_RETIRED_SBE,
+	})
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, map[uint32]nvml.Return{
+		nvml.FI_DEV_NVLINK_CRC_FLIT_ERROR_COUNT_TOTAL: nvml.SUCCESS,
+		nvml.FI_DEV_RETIRED_SBE:                       nvml.ERROR_NOT_SUPPORTED,
+	}, results)
+
+	device.ClearFieldValuesFunc = func(values []nvml.FieldValue) nvml.Return {
+		return nvml.ERROR_FUNCTION_NOT_FOUND
+	}
+	results, ret = nvml.ClearFieldValuesByID(device, []uint32{nvml.FI_DEV_RETIRED_SBE})
+	require.Equal(t, nvml.ERROR_FUNCTION_NOT_FOUND, ret)
+	require.Nil(t, results)
+}
+
+func TestFieldValuesAllDevices(t *testing.T) {
+	const deviceCount = 16
+	ids := []uint32{nvml.FI_DEV_POWER_INSTANT, nvml.FI_DEV_MEMORY_TEMP}
+
+	devices := make([]*mock.Device, deviceCount)
+	for i := range devices {
+		index := i
+		devices[i] = &mock.Device{
+			GetFieldValuesFunc: func(values []nvml.FieldValue) nvml.Return {
+				if index == 3 {
+					return nvml.ERROR_GPU_IS_LOST
+				}
+				for j := range values {
+					values[j].ValueType = uint32(nvml.VALUE_TYPE_UNSIGNED_INT)
+					values[j].Value[0] = byte(index)
+				}
+				return nvml.SUCCESS
+			},
+		}
+	}
+	lib := &mock.Interface{
+		DeviceGetCountFunc: func() (int, nvml.Return) {
+			return deviceCount, nvml.SUCCESS
+		},
+		DeviceGetHandleByIndexFunc: func(index int) (nvml.Device, nvml.Return) {
+			if index == 5 {
+				return nil, nvml.ERROR_NO_PERMISSION
+			}
+			return devices[index], nvml.SUCCESS
+		},
+	}
+
+	values, errors, ret := nvml.FieldValuesAllDevices(lib, ids, 4)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, map[int]nvml.Return{
+		3: nvml.ERROR_GPU_IS_LOST,
+		5: nvml.ERROR_NO_PERMISSION,
+	}, errors)
+
+	require.Len(t, values, deviceCount-1)
+	require.NotContains(t, values, 5)
+	for index, set := range values {
+		require.Len(t, set, len(ids))
+		for _, id := range ids {
+			value := set[id]
+			if index == 3 {
+				require.Equal(t, uint32(nvml.ERROR_GPU_IS_LOST), value.NvmlReturn)
+				continue
+			}
+			v, ok := value.AsUint64()
+			require.True(t, ok)
+			require.Equal(t, uint64(index), v)
+		}
+	}
+	require.Len(t, lib.DeviceGetHandleByIndexCalls(), deviceCount)
+}
+
+func TestFieldValuesAllDevicesCountFails(t *testing.T) {
+	lib := &mock.Interface{
+		DeviceGetCountFunc: func() (int, nvml.Return) {
+			return 0, nvml.ERROR_UNINITIALIZED
+		},
+	}
+
+	_, _, ret := nvml.FieldValuesAllDevices(lib, []uint32{nvml.FI_DEV_POWER_INSTANT}, 0)
+	require.Equal(t, nvml.ERROR_UNINITIALIZED, ret)
+}
+
+func TestFieldValuesAllDevicesNoIds(t *testing.T) {
+	lib := &mock.Interface{}
+
+	values, errors, ret := nvml.FieldValuesAllDevices(lib, nil, 4)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Empty(t, values)
+	require.Empty(t, errors)
+	require.Empty(t, lib.DeviceGetCountCalls())
+}
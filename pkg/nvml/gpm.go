@@ -27,8 +27,8 @@ func (g *GpmMetricsGetType) convert() *nvmlGpmMetricsGetType {
 	out := &nvmlGpmMetricsGetType{
 		Version:    g.Version,
 		NumMetrics: g.NumMetrics,
-		Sample1:    g.Sample1.(nvmlGpmSample),
-		Sample2:    g.Sample2.(nvmlGpmSample),
+		Sample1:    unwrapTimeout(g.Sample1).(nvmlGpmSample),
+		Sample2:    unwrapTimeout(g.Sample2).(nvmlGpmSample),
 	}
 	for i := range g.Metrics {
 		out.Metrics[i] = g.Metrics[i]
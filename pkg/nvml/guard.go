@@ -0,0 +1,94 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"sync"
+)
+
+// Guard protects the use of NVML handles against a concurrent Shutdown.
+//
+// NVML calls are thread-safe, but handles obtained from the library must not
+// be used once Shutdown has been called, and doing so can crash the process.
+// A Guard holds a read lock while a caller uses the library through Do and a
+// write lock for Init and Shutdown. Once the final Shutdown has begun, Do
+// waits for in-flight calls to complete and then rejects new calls with
+// ERROR_UNINITIALIZED until Init is called again.
+//
+// Like NVML itself, a Guard is reference counted: each successful Init must
+// be matched by a call to Shutdown, and the library remains usable through Do
+// until the last of these calls.
+type Guard struct {
+	mu       sync.RWMutex
+	lib      Interface
+	refcount int
+}
+
+// NewGuard creates a Guard for the specified library. The library must be
+// initialized through the Guard.
+func NewGuard(lib Interface) *Guard {
+	return &Guard{lib: lib}
+}
+
+// Init initializes the underlying library and takes a reference on it.
+func (g *Guard) Init() Return {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ret := g.lib.Init()
+	if ret == SUCCESS {
+		g.refcount++
+	}
+	return ret
+}
+
+// Shutdown waits for all calls made through Do to complete and shuts down the
+// underlying library, releasing a reference taken by Init. Once the last
+// reference is released, subsequent calls to Do are rejected. If the library
+// fails to shut down, the reference is kept.
+func (g *Guard) Shutdown() Return {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.refcount == 0 {
+		return ERROR_UNINITIALIZED
+	}
+	ret := g.lib.Shutdown()
+	if ret == SUCCESS {
+		g.refcount--
+	}
+	return ret
+}
+
+// Do calls f with the underlying library while holding the read lock, so that
+// the library cannot be shut down while f is running. Handles obtained from
+// the library must not be retained beyond the call to f. If the library is not
+// initialized, f is not called and ERROR_UNINITIALIZED is returned; otherwise
+// the Return of f is returned.
+//
+// Init and Shutdown wait for f to return, so f must not call them, nor call Do
+// on the same Guard: a nested Do blocks behind a pending Shutdown, which in
+// turn waits for f. Either would deadlock.
+func (g *Guard) Do(f func(lib Interface) Return) Return {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if g.refcount == 0 {
+		return ERROR_UNINITIALIZED
+	}
+	return f(g.lib)
+}
@@ -0,0 +1,202 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestGuard(t *testing.T) {
+	// The shutdown state is intentionally not synchronized, so that the race
+	// detector reports any device call that is not serialized against
+	// Shutdown by the Guard.
+	var shutdown bool
+	device := &mock.Device{
+		GetTemperatureFunc: func(nvml.TemperatureSensors) (uint32, nvml.Return) {
+			if shutdown {
+				return 0, nvml.ERROR_UNKNOWN
+			}
+			return 50, nvml.SUCCESS
+		},
+	}
+	lib := &mock.Interface{
+		InitFunc: func() nvml.Return {
+			shutdown = false
+			return nvml.SUCCESS
+		},
+		ShutdownFunc: func() nvml.Return {
+			shutdown = true
+			return nvml.SUCCESS
+		},
+		DeviceGetHandleByIndexFunc: func(int) (nvml.Device, nvml.Return) {
+			if shutdown {
+				return nil, nvml.ERROR_UNKNOWN
+			}
+			return device, nvml.SUCCESS
+		},
+	}
+
+	guard := nvml.NewGuard(lib)
+	require.Equal(t, nvml.ERROR_UNINITIALIZED, guard.Do(func(nvml.Interface) nvml.Return {
+		t.Fatal("unexpected call before Init")
+		return nvml.SUCCESS
+	}))
+	require.Equal(t, nvml.SUCCESS, guard.Init())
+
+	var wg sync.WaitGroup
+	var shutdownRet nvml.Return
+	results := make(chan nvml.Return, 100)
+	for i := 0; i < cap(results); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results <- guard.Do(func(lib nvml.Interface) nvml.Return {
+				device, ret := lib.DeviceGetHandleByIndex(0)
+				if ret != nvml.SUCCESS {
+					return ret
+				}
+				_, ret = device.GetTemperature(nvml.TEMPERATURE_GPU)
+				return ret
+			})
+		}()
+		if i == cap(results)/2 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				shutdownRet = guard.Shutdown()
+			}()
+		}
+	}
+	wg.Wait()
+	close(results)
+
+	// Calls either complete before Shutdown or are rejected by the Guard;
+	// the library never reports use after shutdown.
+	require.Equal(t, nvml.SUCCESS, shutdownRet)
+	for ret := range results {
+		require.Contains(t, []nvml.Return{nvml.SUCCESS, nvml.ERROR_UNINITIALIZED}, ret)
+	}
+	require.Len(t, lib.ShutdownCalls(), 1)
+	require.Equal(t, nvml.ERROR_UNINITIALIZED, guard.Shutdown())
+
+	require.Equal(t, nvml.SUCCESS, guard.Init())
+	require.Equal(t, nvml.SUCCESS, guard.Do(func(lib nvml.Interface) nvml.Return {
+		_, ret := lib.DeviceGetHandleByIndex(0)
+		return ret
+	}))
+}
+
+func TestGuardRefcount(t *testing.T) {
+	lib := &mock.Interface{
+		InitFunc: func() nvml.Return {
+			return nvml.SUCCESS
+		},
+		ShutdownFunc: func() nvml.Return {
+			return nvml.SUCCESS
+		},
+	}
+	do := func(guard *nvml.Guard) nvml.Return {
+		return guard.Do(func(nvml.Interface) nvml.Return {
+			return nvml.SUCCESS
+		})
+	}
+
+	guard := nvml.NewGuard(lib)
+	require.Equal(t, nvml.SUCCESS, guard.Init())
+	require.Equal(t, nvml.SUCCESS, guard.Init())
+
+	require.Equal(t, nvml.SUCCESS, guard.Shutdown())
+	require.Equal(t, nvml.SUCCESS, do(guard))
+
+	require.Equal(t, nvml.SUCCESS, guard.Shutdown())
+	require.Equal(t, nvml.ERROR_UNINITIALIZED, do(guard))
+	require.Equal(t, nvml.ERROR_UNINITIALIZED, guard.Shutdown())
+	require.Len(t, lib.InitCalls(), 2)
+	require.Len(t, lib.ShutdownCalls(), 2)
+}
+
+func TestGuardShutdownFails(t *testing.T) {
+	lib := &mock.Interface{
+		InitFunc: func() nvml.Return {
+			return nvml.SUCCESS
+		},
+		ShutdownFunc: func() nvml.Return {
+			return nvml.ERROR_UNKNOWN
+		},
+	}
+
+	guard := nvml.NewGuard(lib)
+	require.Equal(t, nvml.SUCCESS, guard.Init())
+	require.Equal(t, nvml.ERROR_UNKNOWN, guard.Shutdown())
+
+	// The library is still initialized, so calls are still allowed.
+	require.Equal(t, nvml.SUCCESS, guard.Do(func(nvml.Interface) nvml.Return {
+		return nvml.SUCCESS
+	}))
+}
+
+func TestGuardShutdownWaitsForDo(t *testing.T) {
+	lib := &mock.Interface{
+		InitFunc: func() nvml.Return {
+			return nvml.SUCCESS
+		},
+		ShutdownFunc: func() nvml.Return {
+			return nvml.SUCCESS
+		},
+	}
+
+	guard := nvml.NewGuard(lib)
+	require.Equal(t, nvml.SUCCESS, guard.Init())
+
+	running := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan nvml.Return)
+	go func() {
+		done <- guard.Do(func(nvml.Interface) nvml.Return {
+			close(running)
+			<-release
+			return nvml.SUCCESS
+		})
+	}()
+	<-running
+
+	shutdown := make(chan nvml.Return)
+	go func() {
+		shutdown <- guard.Shutdown()
+	}()
+
+	// Shutdown cannot complete while f is running, which is why f must not
+	// call it itself.
+	select {
+	case <-shutdown:
+		t.Fatal("Shutdown completed while Do was running")
+	case <-time.After(20 * time.Millisecond):
+	}
+	require.Empty(t, lib.ShutdownCalls())
+
+	close(release)
+	require.Equal(t, nvml.SUCCESS, <-done)
+	require.Equal(t, nvml.SUCCESS, <-shutdown)
+	require.Len(t, lib.ShutdownCalls(), 1)
+}
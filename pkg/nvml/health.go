@@ -0,0 +1,64 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+// HealthSnapshot summarizes the health of a device at a point in time.
+type HealthSnapshot struct {
+	// Healthy is false if any fault was detected.
+	Healthy bool
+	// ClockFaults lists the clock domains reported as faulted by the clock
+	// monitor, e.g. "CLOCK_GRAPHICS".
+	ClockFaults []string
+}
+
+// GetHealthSnapshot captures a HealthSnapshot of the specified device. Devices
+// without a clock monitor, i.e. where GetClkMonStatus returns
+// ERROR_NOT_SUPPORTED, are treated as having no clock faults. Any other error
+// is returned.
+func GetHealthSnapshot(device Device) (HealthSnapshot, Return) {
+	snapshot := HealthSnapshot{
+		ClockFaults: []string{},
+	}
+
+	status, ret := device.GetClkMonStatus()
+	switch {
+	case ret == SUCCESS:
+		snapshot.ClockFaults = clockFaults(status)
+	case !isUnsupported(ret):
+		return snapshot, ret
+	}
+
+	snapshot.Healthy = len(snapshot.ClockFaults) == 0
+	return snapshot, SUCCESS
+}
+
+// clockFaults returns the names of the faulted clock domains in status.
+func clockFaults(status ClkMonStatus) []string {
+	size := int(status.ClkMonListSize)
+	if size > len(status.ClkMonList) {
+		size = len(status.ClkMonList)
+	}
+
+	faults := []string{}
+	for _, info := range status.ClkMonList[:size] {
+		if info.ClkDomainFaultMask == 0 {
+			continue
+		}
+		faults = append(faults, ClockType(info.ClkApiDomain).String())
+	}
+	return faults
+}
@@ -0,0 +1,94 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestGetHealthSnapshot(t *testing.T) {
+	faulted := nvml.ClkMonStatus{
+		BGlobalStatus:  1,
+		ClkMonListSize: 2,
+	}
+	faulted.ClkMonList[0] = nvml.ClkMonFaultInfo{ClkApiDomain: uint32(nvml.CLOCK_GRAPHICS), ClkDomainFaultMask: 0x1}
+	faulted.ClkMonList[1] = nvml.ClkMonFaultInfo{ClkApiDomain: uint32(nvml.CLOCK_MEM), ClkDomainFaultMask: 0x4}
+
+	testCases := []struct {
+		description      string
+		status           nvml.ClkMonStatus
+		ret              nvml.Return
+		expectedSnapshot nvml.HealthSnapshot
+		expectedRet      nvml.Return
+	}{
+		{
+			description: "clock faults",
+			status:      faulted,
+			ret:         nvml.SUCCESS,
+			expectedSnapshot: nvml.HealthSnapshot{
+				Healthy:     false,
+				ClockFaults: []string{"CLOCK_GRAPHICS", "CLOCK_MEM"},
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "no clock faults",
+			ret:         nvml.SUCCESS,
+			expectedSnapshot: nvml.HealthSnapshot{
+				Healthy:     true,
+				ClockFaults: []string{},
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "clock monitor not supported",
+			ret:         nvml.ERROR_NOT_SUPPORTED,
+			expectedSnapshot: nvml.HealthSnapshot{
+				Healthy:     true,
+				ClockFaults: []string{},
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "error",
+			ret:         nvml.ERROR_GPU_IS_LOST,
+			expectedSnapshot: nvml.HealthSnapshot{
+				ClockFaults: []string{},
+			},
+			expectedRet: nvml.ERROR_GPU_IS_LOST,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			device := &mock.Device{
+				GetClkMonStatusFunc: func() (nvml.ClkMonStatus, nvml.Return) {
+					return tc.status, tc.ret
+				},
+			}
+
+			snapshot, ret := nvml.GetHealthSnapshot(device)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expectedSnapshot, snapshot)
+		})
+	}
+}
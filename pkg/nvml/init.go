@@ -25,6 +25,19 @@ func (l *library) Init() Return {
 }
 
 // nvml.InitWithFlags()
+//
+// The flags are a bitmask of the INIT_FLAG_* constants:
+//
+//   - INIT_FLAG_NO_GPUS allows initialization to succeed even when no GPUs
+//     are found. System queries such as SystemGetDriverVersion,
+//     SystemGetNVMLVersion and SystemGetCudaDriverVersion remain usable and
+//     DeviceGetCount reports zero devices.
+//   - INIT_FLAG_NO_ATTACH skips attaching to the GPUs during initialization.
+//     System queries remain usable, and devices are only attached when a
+//     handle is first used, so GPUs in a degraded state do not prevent
+//     initialization.
+//
+// Passing 0 as the flags is equivalent to calling Init.
 func (l *library) InitWithFlags(flags uint32) Return {
 	if err := l.load(); err != nil {
 		return ERROR_LIBRARY_NOT_FOUND
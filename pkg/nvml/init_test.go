@@ -0,0 +1,64 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitWithFlagsLibraryNotFound(t *testing.T) {
+	testCases := []struct {
+		description string
+		flags       uint32
+	}{
+		{
+			description: "no flags",
+			flags:       0,
+		},
+		{
+			description: "no GPUs",
+			flags:       INIT_FLAG_NO_GPUS,
+		},
+		{
+			description: "no attach",
+			flags:       INIT_FLAG_NO_ATTACH,
+		},
+		{
+			description: "no GPUs and no attach",
+			flags:       INIT_FLAG_NO_GPUS | INIT_FLAG_NO_ATTACH,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			dl := &dynamicLibraryMock{
+				OpenFunc: func() error {
+					return errors.New("open error")
+				},
+			}
+			l := newTestLibrary(dl)
+
+			ret := l.InitWithFlags(tc.flags)
+			require.Equal(t, ERROR_LIBRARY_NOT_FOUND, ret)
+			require.Equal(t, 1, len(dl.calls.Open))
+			require.Equal(t, 0, int(l.refcount))
+		})
+	}
+}
@@ -0,0 +1,93 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+// InventoryUnavailable is recorded in a DeviceInventory for fields that are
+// not supported by the device.
+const InventoryUnavailable = "unavailable"
+
+// DeviceInventory holds the identifying information of a device as recorded
+// by InventoryMap.
+type DeviceInventory struct {
+	UUID     string
+	Serial   string
+	PciBusId string
+	Name     string
+}
+
+// InventoryMap returns the inventory of all devices, keyed by device index.
+// Fields that are not supported by a device are recorded as
+// InventoryUnavailable; any other error is returned.
+func InventoryMap(lib Interface) (map[int]DeviceInventory, Return) {
+	count, ret := lib.DeviceGetCount()
+	if ret != SUCCESS {
+		return nil, ret
+	}
+
+	inventory := make(map[int]DeviceInventory, count)
+	for i := 0; i < count; i++ {
+		device, ret := lib.DeviceGetHandleByIndex(i)
+		if ret != SUCCESS {
+			return nil, ret
+		}
+
+		var entry DeviceInventory
+		if entry.UUID, ret = inventoryField(device.GetUUID()); ret != SUCCESS {
+			return nil, ret
+		}
+		if entry.Serial, ret = inventoryField(device.GetSerial()); ret != SUCCESS {
+			return nil, ret
+		}
+		if entry.Name, ret = inventoryField(device.GetName()); ret != SUCCESS {
+			return nil, ret
+		}
+
+		pciInfo, ret := device.GetPciInfo()
+		switch {
+		case ret == SUCCESS:
+			entry.PciBusId = int8ArrayToString(pciInfo.BusId[:])
+		case isUnsupported(ret):
+			entry.PciBusId = InventoryUnavailable
+		default:
+			return nil, ret
+		}
+
+		inventory[i] = entry
+	}
+
+	return inventory, SUCCESS
+}
+
+// inventoryField maps unsupported fields to InventoryUnavailable.
+func inventoryField(value string, ret Return) (string, Return) {
+	if isUnsupported(ret) {
+		return InventoryUnavailable, SUCCESS
+	}
+	return value, ret
+}
+
+// int8ArrayToString converts a NUL-terminated C char array to a string.
+func int8ArrayToString(array []int8) string {
+	bytes := make([]byte, 0, len(array))
+	for _, c := range array {
+		if c == 0 {
+			break
+		}
+		bytes = append(bytes, byte(c))
+	}
+	return string(bytes)
+}
@@ -0,0 +1,96 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestInventoryMap(t *testing.T) {
+	var busId [32]int8
+	for i, c := range "00000000:3B:00.0" {
+		busId[i] = int8(c)
+	}
+
+	devices := []*mock.Device{
+		{
+			GetUUIDFunc: func() (string, nvml.Return) {
+				return "GPU-0", nvml.SUCCESS
+			},
+			GetSerialFunc: func() (string, nvml.Return) {
+				return "1320000000001", nvml.SUCCESS
+			},
+			GetNameFunc: func() (string, nvml.Return) {
+				return "NVIDIA A100", nvml.SUCCESS
+			},
+			GetPciInfoFunc: func() (nvml.PciInfo, nvml.Return) {
+				return nvml.PciInfo{BusId: busId}, nvml.SUCCESS
+			},
+		},
+		{
+			GetUUIDFunc: func() (string, nvml.Return) {
+				return "GPU-1", nvml.SUCCESS
+			},
+			GetSerialFunc: func() (string, nvml.Return) {
+				return "", nvml.ERROR_NOT_SUPPORTED
+			},
+			GetNameFunc: func() (string, nvml.Return) {
+				return "NVIDIA GeForce RTX 4090", nvml.SUCCESS
+			},
+			GetPciInfoFunc: func() (nvml.PciInfo, nvml.Return) {
+				return nvml.PciInfo{}, nvml.ERROR_NOT_SUPPORTED
+			},
+		},
+	}
+
+	lib := &mock.Interface{
+		DeviceGetCountFunc: func() (int, nvml.Return) {
+			return len(devices), nvml.SUCCESS
+		},
+		DeviceGetHandleByIndexFunc: func(index int) (nvml.Device, nvml.Return) {
+			return devices[index], nvml.SUCCESS
+		},
+	}
+
+	inventory, ret := nvml.InventoryMap(lib)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, map[int]nvml.DeviceInventory{
+		0: {
+			UUID:     "GPU-0",
+			Serial:   "1320000000001",
+			PciBusId: "00000000:3B:00.0",
+			Name:     "NVIDIA A100",
+		},
+		1: {
+			UUID:     "GPU-1",
+			Serial:   nvml.InventoryUnavailable,
+			PciBusId: nvml.InventoryUnavailable,
+			Name:     "NVIDIA GeForce RTX 4090",
+		},
+	}, inventory)
+
+	devices[1].GetUUIDFunc = func() (string, nvml.Return) {
+		return "", nvml.ERROR_GPU_IS_LOST
+	}
+	_, ret = nvml.InventoryMap(lib)
+	require.Equal(t, nvml.ERROR_GPU_IS_LOST, ret)
+}
@@ -119,6 +119,9 @@ func (l *library) load() (rerr error) {
 	// Update all versioned symbols
 	l.updateVersionedSymbols()
 
+	// Update all optional symbols
+	l.updateOptionalSymbols()
+
 	return nil
 }
 
@@ -164,6 +167,17 @@ var GetBlacklistDeviceInfoByIndex = GetExcludedDeviceInfoByIndex
 var nvmlDeviceGetGpuInstancePossiblePlacements = nvmlDeviceGetGpuInstancePossiblePlacements_v1
 var nvmlVgpuInstanceGetLicenseInfo = nvmlVgpuInstanceGetLicenseInfo_v1
 
+// APIs that are not present in older drivers. These are checked when the
+// library is loaded (see updateOptionalSymbols).
+var deviceGetPciInfoExt = nvmlDeviceGetPciInfoExt
+var deviceGetCurrentClocksEventReasons = nvmlDeviceGetCurrentClocksEventReasons
+var deviceGetSupportedClocksEventReasons = nvmlDeviceGetSupportedClocksEventReasons
+var deviceGetMemoryInfo_v2 = nvmlDeviceGetMemoryInfo_v2
+var deviceGetFieldValues = nvmlDeviceGetFieldValues
+var deviceClearFieldValues = nvmlDeviceClearFieldValues
+var deviceGetRunningProcessDetailList = nvmlDeviceGetRunningProcessDetailList
+var deviceGetProcessesUtilizationInfo = nvmlDeviceGetProcessesUtilizationInfo
+
 // BlacklistDeviceInfo was replaced by ExcludedDeviceInfo
 type BlacklistDeviceInfo = ExcludedDeviceInfo
 
@@ -289,3 +303,75 @@ func (l *library) updateVersionedSymbols() {
 		nvmlVgpuInstanceGetLicenseInfo = nvmlVgpuInstanceGetLicenseInfo_v2
 	}
 }
+
+// updateOptionalSymbols checks for symbols that are not present in older drivers.
+// Missing symbols are replaced by functions that return ERROR_FUNCTION_NOT_FOUND
+// so that callers can fall back to older APIs instead of calling into a
+// symbol that does not exist. When new optional symbols are added, these would
+// have to be initialized above and have corresponding checks added below.
+func (l *library) updateOptionalSymbols() {
+	err := l.dl.Lookup("nvmlDeviceGetPciInfoExt")
+	if err == nil {
+		deviceGetPciInfoExt = nvmlDeviceGetPciInfoExt
+	} else {
+		deviceGetPciInfoExt = func(nvmlDevice, *PciInfoExt) Return {
+			return ERROR_FUNCTION_NOT_FOUND
+		}
+	}
+	err = l.dl.Lookup("nvmlDeviceGetCurrentClocksEventReasons")
+	if err == nil {
+		deviceGetCurrentClocksEventReasons = nvmlDeviceGetCurrentClocksEventReasons
+	} else {
+		deviceGetCurrentClocksEventReasons = func(nvmlDevice, *uint64) Return {
+			return ERROR_FUNCTION_NOT_FOUND
+		}
+	}
+	err = l.dl.Lookup("nvmlDeviceGetSupportedClocksEventReasons")
+	if err == nil {
+		deviceGetSupportedClocksEventReasons = nvmlDeviceGetSupportedClocksEventReasons
+	} else {
+		deviceGetSupportedClocksEventReasons = func(nvmlDevice, *uint64) Return {
+			return ERROR_FUNCTION_NOT_FOUND
+		}
+	}
+	err = l.dl.Lookup("nvmlDeviceGetMemoryInfo_v2")
+	if err == nil {
+		deviceGetMemoryInfo_v2 = nvmlDeviceGetMemoryInfo_v2
+	} else {
+		deviceGetMemoryInfo_v2 = func(nvmlDevice, *Memory_v2) Return {
+			return ERROR_FUNCTION_NOT_FOUND
+		}
+	}
+	err = l.dl.Lookup("nvmlDeviceGetFieldValues")
+	if err == nil {
+		deviceGetFieldValues = nvmlDeviceGetFieldValues
+	} else {
+		deviceGetFieldValues = func(nvmlDevice, int32, *FieldValue) Return {
+			return ERROR_FUNCTION_NOT_FOUND
+		}
+	}
+	err = l.dl.Lookup("nvmlDeviceClearFieldValues")
+	if err == nil {
+		deviceClearFieldValues = nvmlDeviceClearFieldValues
+	} else {
+		deviceClearFieldValues = func(nvmlDevice, int32, *FieldValue) Return {
+			return ERROR_FUNCTION_NOT_FOUND
+		}
+	}
+	err = l.dl.Lookup("nvmlDeviceGetRunningProcessDetailList")
+	if err == nil {
+		deviceGetRunningProcessDetailList = nvmlDeviceGetRunningProcessDetailList
+	} else {
+		deviceGetRunningProcessDetailList = func(nvmlDevice, *ProcessDetailList) Return {
+			return ERROR_FUNCTION_NOT_FOUND
+		}
+	}
+	err = l.dl.Lookup("nvmlDeviceGetProcessesUtilizationInfo")
+	if err == nil {
+		deviceGetProcessesUtilizationInfo = nvmlDeviceGetProcessesUtilizationInfo
+	} else {
+		deviceGetProcessesUtilizationInfo = func(nvmlDevice, *ProcessesUtilizationInfo) Return {
+			return ERROR_FUNCTION_NOT_FOUND
+		}
+	}
+}
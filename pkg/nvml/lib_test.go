@@ -19,6 +19,7 @@ package nvml
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -243,3 +244,181 @@ func TestLoadAndCloseWithErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestMissingOptionalSymbols(t *testing.T) {
+	defer restoreOptionalSymbolsForTest()()
+
+	testCases := []struct {
+		symbol string
+		call   func() Return
+	}{
+		{
+			symbol: "nvmlDeviceGetPciInfoExt",
+			call: func() Return {
+				_, ret := nvmlDevice{}.GetPciInfoExt()
+				return ret
+			},
+		},
+		{
+			symbol: "nvmlDeviceGetCurrentClocksEventReasons",
+			call: func() Return {
+				_, ret := nvmlDevice{}.GetCurrentClocksEventReasons()
+				return ret
+			},
+		},
+		{
+			symbol: "nvmlDeviceGetSupportedClocksEventReasons",
+			call: func() Return {
+				_, ret := nvmlDevice{}.GetSupportedClocksEventReasons()
+				return ret
+			},
+		},
+		{
+			symbol: "nvmlDeviceGetMemoryInfo_v2",
+			call: func() Return {
+				_, ret := nvmlDevice{}.GetMemoryInfo_v2()
+				return ret
+			},
+		},
+		{
+			symbol: "nvmlDeviceGetFieldValues",
+			call: func() Return {
+				return nvmlDevice{}.GetFieldValues(make([]FieldValue, 1))
+			},
+		},
+		{
+			symbol: "nvmlDeviceClearFieldValues",
+			call: func() Return {
+				return nvmlDevice{}.ClearFieldValues(make([]FieldValue, 1))
+			},
+		},
+		{
+			symbol: "nvmlDeviceGetRunningProcessDetailList",
+			call: func() Return {
+				_, ret := nvmlDevice{}.GetRunningProcessDetailList()
+				return ret
+			},
+		},
+		{
+			symbol: "nvmlDeviceGetProcessesUtilizationInfo",
+			call: func() Return {
+				_, _, ret := nvmlDevice{}.GetProcessesUtilizationInfo(0)
+				return ret
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.symbol, func(t *testing.T) {
+			dl := &dynamicLibraryMock{
+				OpenFunc: func() error {
+					return nil
+				},
+				LookupFunc: func(s string) error {
+					if s == tc.symbol {
+						return errors.New("symbol not found")
+					}
+					return nil
+				},
+				CloseFunc: func() error {
+					return nil
+				},
+			}
+			l := newTestLibrary(dl)
+			require.NoError(t, l.load())
+			defer func() { require.NoError(t, l.close()) }()
+
+			require.Equal(t, ERROR_FUNCTION_NOT_FOUND, tc.call())
+		})
+	}
+}
+
+func restoreOptionalSymbolsForTest() func() {
+	pciInfoExt := deviceGetPciInfoExt
+	currentClocksEventReasons := deviceGetCurrentClocksEventReasons
+	supportedClocksEventReasons := deviceGetSupportedClocksEventReasons
+	memoryInfo_v2 := deviceGetMemoryInfo_v2
+	getFieldValues := deviceGetFieldValues
+	clearFieldValues := deviceClearFieldValues
+	runningProcessDetailList := deviceGetRunningProcessDetailList
+	processesUtilizationInfo := deviceGetProcessesUtilizationInfo
+	return func() {
+		deviceGetPciInfoExt = pciInfoExt
+		deviceGetCurrentClocksEventReasons = currentClocksEventReasons
+		deviceGetSupportedClocksEventReasons = supportedClocksEventReasons
+		deviceGetMemoryInfo_v2 = memoryInfo_v2
+		deviceGetFieldValues = getFieldValues
+		deviceClearFieldValues = clearFieldValues
+		deviceGetRunningProcessDetailList = runningProcessDetailList
+		deviceGetProcessesUtilizationInfo = processesUtilizationInfo
+	}
+}
+
+func TestComputeRunningProcessesVersionSelection(t *testing.T) {
+	original := deviceGetComputeRunningProcesses
+	defer func() { deviceGetComputeRunningProcesses = original }()
+
+	testCases := []struct {
+		description string
+		symbols     []string
+		expected    func(nvmlDevice) ([]ProcessInfo, Return)
+	}{
+		{
+			description: "v3 is preferred",
+			symbols: []string{
+				"nvmlDeviceGetComputeRunningProcesses_v2",
+				"nvmlDeviceGetComputeRunningProcesses_v3",
+			},
+			expected: deviceGetComputeRunningProcesses_v3,
+		},
+		{
+			description: "v2 is used if v3 is missing",
+			symbols: []string{
+				"nvmlDeviceGetComputeRunningProcesses_v2",
+			},
+			expected: deviceGetComputeRunningProcesses_v2,
+		},
+		{
+			description: "v1 is used if no versioned symbols exist",
+			expected:    deviceGetComputeRunningProcesses_v1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			deviceGetComputeRunningProcesses = deviceGetComputeRunningProcesses_v1
+			l := newTestLibrary(&dynamicLibraryMock{
+				LookupFunc: func(s string) error {
+					for _, symbol := range tc.symbols {
+						if s == symbol {
+							return nil
+						}
+					}
+					return errors.New("symbol not found")
+				},
+			})
+			l.updateVersionedSymbols()
+
+			require.Equal(t,
+				reflect.ValueOf(tc.expected).Pointer(),
+				reflect.ValueOf(deviceGetComputeRunningProcesses).Pointer(),
+			)
+		})
+	}
+}
+
+func TestToProcessInfoSlice(t *testing.T) {
+	v1 := ProcessInfo_v1Slice{
+		{Pid: 1234, UsedGpuMemory: 1024},
+	}
+	require.Equal(t, []ProcessInfo{
+		{Pid: 1234, UsedGpuMemory: 1024, GpuInstanceId: 0xFFFFFFFF, ComputeInstanceId: 0xFFFFFFFF},
+	}, v1.ToProcessInfoSlice())
+
+	v2 := ProcessInfo_v2Slice{
+		{Pid: 1234, UsedGpuMemory: 1024, GpuInstanceId: 1, ComputeInstanceId: 0},
+	}
+	require.Equal(t, []ProcessInfo{
+		{Pid: 1234, UsedGpuMemory: 1024, GpuInstanceId: 1, ComputeInstanceId: 0},
+	}, v2.ToProcessInfoSlice())
+}
@@ -0,0 +1,116 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// WriteLineProtocol writes the metrics of a device to w as a single line in
+// the InfluxDB line protocol. The line is tagged with the UUID and index of
+// the device in addition to the specified tags, and holds the following
+// integer fields:
+//
+//	gpu_utilization     GPU utilization in percent
+//	memory_utilization  memory utilization in percent
+//	temperature         GPU temperature in degrees C
+//	power_usage         power draw in milliwatts
+//	memory_used         memory used in bytes
+//
+// Metrics that are not supported by the device are omitted from the line; if
+// none is supported, nothing is written. No timestamp is written, so the time
+// of ingestion is used. Errors other than unsupported queries are returned as
+// a Return.
+func WriteLineProtocol(w io.Writer, d Device, measurement string, tags map[string]string) error {
+	uuid, ret := d.GetUUID()
+	if ret != SUCCESS {
+		return ret
+	}
+	index, ret := d.GetIndex()
+	if ret != SUCCESS {
+		return ret
+	}
+
+	var fields []string
+	addField := func(name string, value uint64, ret Return) Return {
+		ok, ret := available(ret)
+		if ok {
+			fields = append(fields, fmt.Sprintf("%s=%di", name, value))
+		}
+		return ret
+	}
+
+	utilization, ret := d.GetUtilizationRates()
+	if ret = addField("gpu_utilization", uint64(utilization.Gpu), ret); ret != SUCCESS {
+		return ret
+	}
+	if ret = addField("memory_utilization", uint64(utilization.Memory), ret); ret != SUCCESS {
+		return ret
+	}
+	temperature, ret := d.GetTemperature(TEMPERATURE_GPU)
+	if ret = addField("temperature", uint64(temperature), ret); ret != SUCCESS {
+		return ret
+	}
+	power, ret := d.GetPowerUsage()
+	if ret = addField("power_usage", uint64(power), ret); ret != SUCCESS {
+		return ret
+	}
+	memory, ret := d.GetMemoryInfo()
+	if ret = addField("memory_used", memory.Used, ret); ret != SUCCESS {
+		return ret
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	allTags := map[string]string{
+		"uuid":  uuid,
+		"index": fmt.Sprint(index),
+	}
+	for k, v := range tags {
+		allTags[k] = v
+	}
+	// Tags are sorted by key, as recommended for ingestion performance.
+	keys := make([]string, 0, len(allTags))
+	for k := range allTags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var line strings.Builder
+	line.WriteString(measurementEscaper.Replace(measurement))
+	for _, k := range keys {
+		fmt.Fprintf(&line, ",%s=%s", tagEscaper.Replace(k), tagEscaper.Replace(allTags[k]))
+	}
+	line.WriteString(" ")
+	line.WriteString(strings.Join(fields, ","))
+	line.WriteString("\n")
+
+	_, err := io.WriteString(w, line.String())
+	return err
+}
+
+var (
+	// measurementEscaper escapes the special characters of measurement names.
+	measurementEscaper = strings.NewReplacer(",", `\,`, " ", `\ `)
+	// tagEscaper escapes the special characters of tag keys and values.
+	tagEscaper = strings.NewReplacer(",", `\,`, " ", `\ `, "=", `\=`)
+)
@@ -0,0 +1,69 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestWriteLineProtocol(t *testing.T) {
+	device := &mock.Device{
+		GetUUIDFunc: func() (string, nvml.Return) {
+			return "GPU-1234", nvml.SUCCESS
+		},
+		GetIndexFunc: func() (int, nvml.Return) {
+			return 1, nvml.SUCCESS
+		},
+		GetUtilizationRatesFunc: func() (nvml.Utilization, nvml.Return) {
+			return nvml.Utilization{Gpu: 75, Memory: 30}, nvml.SUCCESS
+		},
+		GetTemperatureFunc: func(nvml.TemperatureSensors) (uint32, nvml.Return) {
+			return 65, nvml.SUCCESS
+		},
+		GetPowerUsageFunc: func() (uint32, nvml.Return) {
+			return 0, nvml.ERROR_NOT_SUPPORTED
+		},
+		GetMemoryInfoFunc: func() (nvml.Memory, nvml.Return) {
+			return nvml.Memory{Used: 1 << 30}, nvml.SUCCESS
+		},
+	}
+
+	var buf bytes.Buffer
+	err := nvml.WriteLineProtocol(&buf, device, "gpu metrics", map[string]string{
+		"host": "node-1",
+		"rack": "a,b",
+	})
+	require.NoError(t, err)
+	require.Equal(t,
+		`gpu\ metrics,host=node-1,index=1,rack=a\,b,uuid=GPU-1234 gpu_utilization=75i,memory_utilization=30i,temperature=65i,memory_used=1073741824i`+"\n",
+		buf.String(),
+	)
+
+	device.GetTemperatureFunc = func(nvml.TemperatureSensors) (uint32, nvml.Return) {
+		return 0, nvml.ERROR_GPU_IS_LOST
+	}
+	buf.Reset()
+	err = nvml.WriteLineProtocol(&buf, device, "gpu", nil)
+	require.ErrorIs(t, err, nvml.ERROR_GPU_IS_LOST)
+	require.Empty(t, buf.String())
+}
@@ -0,0 +1,65 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+// MemoryPressure describes how much of the memory of a device is in use by
+// applications.
+type MemoryPressure struct {
+	// UsedByApps is the memory allocated by applications in bytes.
+	UsedByApps uint64
+	// Total is the memory available to applications in bytes.
+	Total uint64
+	// Fraction is UsedByApps divided by Total.
+	Fraction float64
+	// ReservedExcluded is set if memory reserved by the driver and firmware
+	// could be excluded from UsedByApps and Total.
+	ReservedExcluded bool
+}
+
+// GetMemoryPressure returns the memory pressure of a device.
+//
+// Whether the used memory reported by GetMemoryInfo includes the memory
+// reserved by the driver and firmware depends on the driver version. To report
+// consistent values, GetMemoryInfo_v2 is preferred since it reports the
+// reserved memory separately; it is excluded from both UsedByApps and Total.
+// If the v2 query is not available, the values reported by GetMemoryInfo are
+// used as is and ReservedExcluded is false.
+func GetMemoryPressure(device Device) (MemoryPressure, Return) {
+	var pressure MemoryPressure
+
+	memory_v2, ret := device.GetMemoryInfo_v2()
+	switch {
+	case ret == SUCCESS:
+		pressure.UsedByApps = memory_v2.Used
+		pressure.Total = memory_v2.Total - memory_v2.Reserved
+		pressure.ReservedExcluded = true
+	case isUnsupported(ret) || ret == ERROR_ARGUMENT_VERSION_MISMATCH:
+		memory, ret := device.GetMemoryInfo()
+		if ret != SUCCESS {
+			return pressure, ret
+		}
+		pressure.UsedByApps = memory.Used
+		pressure.Total = memory.Total
+	default:
+		return pressure, ret
+	}
+
+	if pressure.Total > 0 {
+		pressure.Fraction = float64(pressure.UsedByApps) / float64(pressure.Total)
+	}
+	return pressure, SUCCESS
+}
@@ -0,0 +1,105 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestGetMemoryPressure(t *testing.T) {
+	const gib = 1 << 30
+
+	testCases := []struct {
+		description      string
+		memory_v2        nvml.Memory_v2
+		memory_v2Ret     nvml.Return
+		memory           nvml.Memory
+		memoryRet        nvml.Return
+		expectedPressure nvml.MemoryPressure
+		expectedRet      nvml.Return
+	}{
+		{
+			description:  "v2 available",
+			memory_v2:    nvml.Memory_v2{Total: 81 * gib, Reserved: 1 * gib, Used: 20 * gib, Free: 60 * gib},
+			memory_v2Ret: nvml.SUCCESS,
+			expectedPressure: nvml.MemoryPressure{
+				UsedByApps:       20 * gib,
+				Total:            80 * gib,
+				Fraction:         0.25,
+				ReservedExcluded: true,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description:  "v2 absent",
+			memory_v2Ret: nvml.ERROR_FUNCTION_NOT_FOUND,
+			memory:       nvml.Memory{Total: 80 * gib, Used: 40 * gib, Free: 40 * gib},
+			memoryRet:    nvml.SUCCESS,
+			expectedPressure: nvml.MemoryPressure{
+				UsedByApps: 40 * gib,
+				Total:      80 * gib,
+				Fraction:   0.5,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description:  "v2 struct not supported by driver",
+			memory_v2Ret: nvml.ERROR_ARGUMENT_VERSION_MISMATCH,
+			memory:       nvml.Memory{Total: 80 * gib, Used: 20 * gib, Free: 60 * gib},
+			memoryRet:    nvml.SUCCESS,
+			expectedPressure: nvml.MemoryPressure{
+				UsedByApps: 20 * gib,
+				Total:      80 * gib,
+				Fraction:   0.25,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description:  "v2 error",
+			memory_v2Ret: nvml.ERROR_GPU_IS_LOST,
+			expectedRet:  nvml.ERROR_GPU_IS_LOST,
+		},
+		{
+			description:  "v1 error",
+			memory_v2Ret: nvml.ERROR_NOT_SUPPORTED,
+			memoryRet:    nvml.ERROR_NOT_SUPPORTED,
+			expectedRet:  nvml.ERROR_NOT_SUPPORTED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			device := &mock.Device{
+				GetMemoryInfo_v2Func: func() (nvml.Memory_v2, nvml.Return) {
+					return tc.memory_v2, tc.memory_v2Ret
+				},
+				GetMemoryInfoFunc: func() (nvml.Memory, nvml.Return) {
+					return tc.memory, tc.memoryRet
+				},
+			}
+
+			pressure, ret := nvml.GetMemoryPressure(device)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expectedPressure, pressure)
+		})
+	}
+}
@@ -0,0 +1,243 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// EnableMIGAndWait sets the MIG mode of device and polls GetMigMode every
+// pollInterval until the current mode matches the requested one or ctx is
+// done.
+//
+// A MIG mode change normally stays pending until the GPU is reset, which this
+// helper does not do itself; the reset is expected to be triggered by the
+// caller or an operator while it waits. If NVML reports that processes on the
+// GPU prevent the change from being activated, ERROR_IN_USE is returned as
+// soon as GetMigMode shows the change still pending, rather than waiting for
+// a reset that cannot take effect. ERROR_TIMEOUT is returned if ctx is done
+// before the mode takes effect.
+// ERROR_INVALID_ARGUMENT is returned, before the mode is changed, if
+// pollInterval is not positive.
+func EnableMIGAndWait(ctx context.Context, device Device, enabled bool, pollInterval time.Duration) Return {
+	if pollInterval <= 0 {
+		return ERROR_INVALID_ARGUMENT
+	}
+
+	mode := DEVICE_MIG_DISABLE
+	if enabled {
+		mode = DEVICE_MIG_ENABLE
+	}
+
+	activationStatus, ret := device.SetMigMode(mode)
+	if ret != SUCCESS {
+		return ret
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		current, pending, ret := device.GetMigMode()
+		if ret != SUCCESS {
+			return ret
+		}
+		if current == mode {
+			return SUCCESS
+		}
+		if activationStatus == ERROR_IN_USE && pending == mode {
+			return ERROR_IN_USE
+		}
+
+		select {
+		case <-ctx.Done():
+			return ERROR_TIMEOUT
+		case <-ticker.C:
+		}
+	}
+}
+
+// maxMigDevicesPerGpu is the maximum number of MIG devices that can be
+// created on a single GPU.
+const maxMigDevicesPerGpu = 7
+
+// GetMigDevices returns the MIG devices of a device, in index order. The
+// indices are bounded by GetMaxMigDeviceCount; on drivers that do not
+// implement it (ERROR_FUNCTION_NOT_FOUND), the NVML limit of 7 MIG devices per
+// GPU is used instead, and the list ends at the first index the device reports
+// as invalid. Indices without a MIG device are skipped.
+func GetMigDevices(device Device) ([]Device, Return) {
+	count, ret := device.GetMaxMigDeviceCount()
+	fallback := ret == ERROR_FUNCTION_NOT_FOUND
+	if fallback {
+		count, ret = maxMigDevicesPerGpu, SUCCESS
+	}
+	if ret != SUCCESS {
+		return nil, ret
+	}
+
+	migDevices := []Device{}
+	for index := 0; index < count; index++ {
+		migDevice, exists, ret := GetMigDeviceHandleByIndexOpt(device, index)
+		// Indices beyond those supported by the device are reported as
+		// invalid. The fallback bound may exceed them, but an index below
+		// the count reported by the driver must be valid.
+		if ret == ERROR_INVALID_ARGUMENT && fallback {
+			break
+		}
+		if ret != SUCCESS {
+			return nil, ret
+		}
+		if exists {
+			migDevices = append(migDevices, migDevice)
+		}
+	}
+	return migDevices, SUCCESS
+}
+
+// GetMigDeviceHandleByIndexOpt returns the MIG device at the specified index
+// of a device. An index without a MIG device, reported by NVML as
+// ERROR_NOT_FOUND, returns false and SUCCESS. Any other error, such as
+// ERROR_INVALID_ARGUMENT for an index that is out of range, is returned as is.
+func GetMigDeviceHandleByIndexOpt(device Device, index int) (Device, bool, Return) {
+	migDevice, ret := device.GetMigDeviceHandleByIndex(index)
+	switch ret {
+	case SUCCESS:
+		return migDevice, true, SUCCESS
+	case ERROR_NOT_FOUND:
+		return nil, false, SUCCESS
+	default:
+		return nil, false, ret
+	}
+}
+
+// MigGpuInstance describes a GPU instance, the profile it was created from,
+// and the compute instances created in it.
+type MigGpuInstance struct {
+	Info             GpuInstanceInfo
+	Profile          GpuInstanceProfileInfo
+	ComputeInstances []MigComputeInstance
+}
+
+// MigComputeInstance describes a compute instance and the profile it was
+// created from.
+type MigComputeInstance struct {
+	Info    ComputeInstanceInfo
+	Profile ComputeInstanceProfileInfo
+}
+
+// GetMigLayout returns the MIG partitioning of a device: its GPU instances
+// and the compute instances within each of them, together with their
+// profiles, ids and placements. GPU instances are ordered by id, as are the
+// compute instances within them. Profiles that the device does not support
+// are skipped. A device with MIG disabled, or without MIG support, returns an
+// empty layout.
+func GetMigLayout(device Device) ([]MigGpuInstance, Return) {
+	layout := []MigGpuInstance{}
+
+	current, _, ret := device.GetMigMode()
+	if isUnsupported(ret) {
+		return layout, SUCCESS
+	}
+	if ret != SUCCESS {
+		return nil, ret
+	}
+	if current != DEVICE_MIG_ENABLE {
+		return layout, SUCCESS
+	}
+
+	for profile := 0; profile < GPU_INSTANCE_PROFILE_COUNT; profile++ {
+		profileInfo, ret := device.GetGpuInstanceProfileInfo(profile)
+		if skipMigProfile(ret) {
+			continue
+		}
+		if ret != SUCCESS {
+			return nil, ret
+		}
+
+		gpuInstances, ret := device.GetGpuInstances(&profileInfo)
+		if ret != SUCCESS {
+			return nil, ret
+		}
+		for _, gpuInstance := range gpuInstances {
+			entry, ret := getMigGpuInstance(gpuInstance)
+			if ret != SUCCESS {
+				return nil, ret
+			}
+			entry.Profile = profileInfo
+			layout = append(layout, entry)
+		}
+	}
+
+	sort.Slice(layout, func(i, j int) bool {
+		return layout[i].Info.Id < layout[j].Info.Id
+	})
+	return layout, SUCCESS
+}
+
+// getMigGpuInstance returns the info of a GPU instance along with that of its
+// compute instances.
+func getMigGpuInstance(gpuInstance GpuInstance) (MigGpuInstance, Return) {
+	info, ret := gpuInstance.GetInfo()
+	if ret != SUCCESS {
+		return MigGpuInstance{}, ret
+	}
+
+	entry := MigGpuInstance{
+		Info:             info,
+		ComputeInstances: []MigComputeInstance{},
+	}
+	for profile := 0; profile < COMPUTE_INSTANCE_PROFILE_COUNT; profile++ {
+		for engProfile := 0; engProfile < COMPUTE_INSTANCE_ENGINE_PROFILE_COUNT; engProfile++ {
+			profileInfo, ret := gpuInstance.GetComputeInstanceProfileInfo(profile, engProfile)
+			if skipMigProfile(ret) {
+				continue
+			}
+			if ret != SUCCESS {
+				return MigGpuInstance{}, ret
+			}
+
+			computeInstances, ret := gpuInstance.GetComputeInstances(&profileInfo)
+			if ret != SUCCESS {
+				return MigGpuInstance{}, ret
+			}
+			for _, computeInstance := range computeInstances {
+				info, ret := computeInstance.GetInfo()
+				if ret != SUCCESS {
+					return MigGpuInstance{}, ret
+				}
+				entry.ComputeInstances = append(entry.ComputeInstances, MigComputeInstance{
+					Info:    info,
+					Profile: profileInfo,
+				})
+			}
+		}
+	}
+
+	sort.Slice(entry.ComputeInstances, func(i, j int) bool {
+		return entry.ComputeInstances[i].Info.Id < entry.ComputeInstances[j].Info.Id
+	})
+	return entry, SUCCESS
+}
+
+// skipMigProfile reports whether a profile info query failed because the
+// profile is not available on the device or GPU instance.
+func skipMigProfile(ret Return) bool {
+	return isUnsupported(ret) || ret == ERROR_INVALID_ARGUMENT
+}
@@ -0,0 +1,423 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestEnableMIGAndWait(t *testing.T) {
+	testCases := []struct {
+		description      string
+		activationStatus nvml.Return
+		setRet           nvml.Return
+		flipAfter        int
+		timeout          time.Duration
+		expectedRet      nvml.Return
+	}{
+		{
+			description: "mode flips after reset",
+			flipAfter:   3,
+			timeout:     time.Second,
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "activated immediately",
+			timeout:     time.Second,
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description:      "processes prevent the change",
+			activationStatus: nvml.ERROR_IN_USE,
+			flipAfter:        -1,
+			timeout:          time.Minute,
+			expectedRet:      nvml.ERROR_IN_USE,
+		},
+		{
+			description: "reset never happens",
+			flipAfter:   -1,
+			timeout:     20 * time.Millisecond,
+			expectedRet: nvml.ERROR_TIMEOUT,
+		},
+		{
+			description: "not permitted",
+			setRet:      nvml.ERROR_NO_PERMISSION,
+			timeout:     time.Second,
+			expectedRet: nvml.ERROR_NO_PERMISSION,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			current := nvml.DEVICE_MIG_DISABLE
+			pending := nvml.DEVICE_MIG_DISABLE
+			polls := 0
+			device := &mock.Device{
+				SetMigModeFunc: func(mode int) (nvml.Return, nvml.Return) {
+					if tc.setRet != nvml.SUCCESS {
+						return nvml.SUCCESS, tc.setRet
+					}
+					pending = mode
+					return tc.activationStatus, nvml.SUCCESS
+				},
+				GetMigModeFunc: func() (int, int, nvml.Return) {
+					if tc.flipAfter >= 0 && polls >= tc.flipAfter {
+						current = pending
+					}
+					polls++
+					return current, pending, nvml.SUCCESS
+				},
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), tc.timeout)
+			defer cancel()
+
+			start := time.Now()
+			ret := nvml.EnableMIGAndWait(ctx, device, true, time.Millisecond)
+			require.Equal(t, tc.expectedRet, ret)
+			if tc.expectedRet == nvml.ERROR_IN_USE {
+				// The call must not wait for the deadline.
+				require.Less(t, time.Since(start), time.Second)
+				require.Equal(t, 1, polls)
+			}
+			if tc.expectedRet == nvml.SUCCESS {
+				require.Equal(t, nvml.DEVICE_MIG_ENABLE, current)
+				require.Equal(t, tc.flipAfter+1, polls)
+			}
+		})
+	}
+}
+
+func TestEnableMIGAndWaitInvalidInterval(t *testing.T) {
+	device := &mock.Device{}
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		ret := nvml.EnableMIGAndWait(context.Background(), device, true, interval)
+		require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, ret)
+	}
+	require.Empty(t, device.SetMigModeCalls())
+}
+
+func TestGetMigLayout(t *testing.T) {
+	device := &mock.Device{
+		GetMigModeFunc: func() (int, int, nvml.Return) {
+			return nvml.DEVICE_MIG_ENABLE, nvml.DEVICE_MIG_ENABLE, nvml.SUCCESS
+		},
+	}
+
+	newComputeInstance := func(gpuInstance nvml.GpuInstance, id uint32, profileId uint32) nvml.ComputeInstance {
+		return &mock.ComputeInstance{
+			GetInfoFunc: func() (nvml.ComputeInstanceInfo, nvml.Return) {
+				return nvml.ComputeInstanceInfo{
+					Device:      device,
+					GpuInstance: gpuInstance,
+					Id:          id,
+					ProfileId:   profileId,
+					Placement:   nvml.ComputeInstancePlacement{Start: id, Size: 1},
+				}, nvml.SUCCESS
+			},
+		}
+	}
+
+	// computeInstances maps the compute instance profiles of a GPU instance to
+	// the ids of its compute instances created from them.
+	newGpuInstance := func(id uint32, profileId uint32, computeInstances map[int][]uint32) nvml.GpuInstance {
+		gpuInstance := &mock.GpuInstance{}
+		gpuInstance.GetInfoFunc = func() (nvml.GpuInstanceInfo, nvml.Return) {
+			return nvml.GpuInstanceInfo{
+				Device:    device,
+				Id:        id,
+				ProfileId: profileId,
+				Placement: nvml.GpuInstancePlacement{Start: id, Size: 4},
+			}, nvml.SUCCESS
+		}
+		gpuInstance.GetComputeInstanceProfileInfoFunc = func(profile int, engProfile int) (nvml.ComputeInstanceProfileInfo, nvml.Return) {
+			if _, exists := computeInstances[profile]; !exists {
+				return nvml.ComputeInstanceProfileInfo{}, nvml.ERROR_INVALID_ARGUMENT
+			}
+			return nvml.ComputeInstanceProfileInfo{Id: uint32(profile), SliceCount: uint32(profile + 1)}, nvml.SUCCESS
+		}
+		gpuInstance.GetComputeInstancesFunc = func(info *nvml.ComputeInstanceProfileInfo) ([]nvml.ComputeInstance, nvml.Return) {
+			var cis []nvml.ComputeInstance
+			for _, ciId := range computeInstances[int(info.Id)] {
+				cis = append(cis, newComputeInstance(gpuInstance, ciId, info.Id))
+			}
+			return cis, nvml.SUCCESS
+		}
+		return gpuInstance
+	}
+
+	gpuInstances := map[int][]nvml.GpuInstance{
+		nvml.GPU_INSTANCE_PROFILE_4_SLICE: {
+			newGpuInstance(2, nvml.GPU_INSTANCE_PROFILE_4_SLICE, map[int][]uint32{
+				nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE: {0},
+			}),
+			newGpuInstance(1, nvml.GPU_INSTANCE_PROFILE_4_SLICE, map[int][]uint32{
+				nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE: {1},
+				nvml.COMPUTE_INSTANCE_PROFILE_2_SLICE: {0},
+			}),
+		},
+	}
+	device.GetGpuInstanceProfileInfoFunc = func(profile int) (nvml.GpuInstanceProfileInfo, nvml.Return) {
+		if _, exists := gpuInstances[profile]; !exists {
+			return nvml.GpuInstanceProfileInfo{}, nvml.ERROR_NOT_SUPPORTED
+		}
+		return nvml.GpuInstanceProfileInfo{Id: uint32(profile), SliceCount: 4}, nvml.SUCCESS
+	}
+	device.GetGpuInstancesFunc = func(info *nvml.GpuInstanceProfileInfo) ([]nvml.GpuInstance, nvml.Return) {
+		return gpuInstances[int(info.Id)], nvml.SUCCESS
+	}
+
+	layout, ret := nvml.GetMigLayout(device)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, layout, 2)
+
+	type computeInstance struct {
+		id        uint32
+		profileId uint32
+		start     uint32
+	}
+	var gpuInstanceIds []uint32
+	var computeInstances [][]computeInstance
+	for _, gi := range layout {
+		require.Equal(t, device, gi.Info.Device)
+		require.Equal(t, uint32(nvml.GPU_INSTANCE_PROFILE_4_SLICE), gi.Info.ProfileId)
+		require.Equal(t, gi.Info.ProfileId, gi.Profile.Id)
+		require.Equal(t, nvml.GpuInstancePlacement{Start: gi.Info.Id, Size: 4}, gi.Info.Placement)
+		gpuInstanceIds = append(gpuInstanceIds, gi.Info.Id)
+
+		var cis []computeInstance
+		for _, ci := range gi.ComputeInstances {
+			gpuInstanceInfo, ret := ci.Info.GpuInstance.GetInfo()
+			require.Equal(t, nvml.SUCCESS, ret)
+			require.Equal(t, gi.Info.Id, gpuInstanceInfo.Id)
+			require.Equal(t, ci.Info.ProfileId, ci.Profile.Id)
+			cis = append(cis, computeInstance{ci.Info.Id, ci.Info.ProfileId, ci.Info.Placement.Start})
+		}
+		computeInstances = append(computeInstances, cis)
+	}
+	require.Equal(t, []uint32{1, 2}, gpuInstanceIds)
+	require.Equal(t, [][]computeInstance{
+		{
+			{id: 0, profileId: nvml.COMPUTE_INSTANCE_PROFILE_2_SLICE, start: 0},
+			{id: 1, profileId: nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE, start: 1},
+		},
+		{
+			{id: 0, profileId: nvml.COMPUTE_INSTANCE_PROFILE_1_SLICE, start: 0},
+		},
+	}, computeInstances)
+}
+
+func TestGetMigLayoutMigDisabled(t *testing.T) {
+	testCases := []struct {
+		description string
+		mode        int
+		ret         nvml.Return
+		expectedRet nvml.Return
+	}{
+		{
+			description: "MIG disabled",
+			mode:        nvml.DEVICE_MIG_DISABLE,
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "MIG not supported",
+			ret:         nvml.ERROR_NOT_SUPPORTED,
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "MIG mode query fails",
+			ret:         nvml.ERROR_UNKNOWN,
+			expectedRet: nvml.ERROR_UNKNOWN,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			device := &mock.Device{
+				GetMigModeFunc: func() (int, int, nvml.Return) {
+					return tc.mode, tc.mode, tc.ret
+				},
+			}
+
+			layout, ret := nvml.GetMigLayout(device)
+			require.Equal(t, tc.expectedRet, ret)
+			if tc.expectedRet == nvml.SUCCESS {
+				require.Empty(t, layout)
+				require.NotNil(t, layout)
+			}
+			require.Empty(t, device.GetGpuInstanceProfileInfoCalls())
+		})
+	}
+}
+
+func TestGetMigLayoutError(t *testing.T) {
+	device := &mock.Device{
+		GetMigModeFunc: func() (int, int, nvml.Return) {
+			return nvml.DEVICE_MIG_ENABLE, nvml.DEVICE_MIG_ENABLE, nvml.SUCCESS
+		},
+		GetGpuInstanceProfileInfoFunc: func(profile int) (nvml.GpuInstanceProfileInfo, nvml.Return) {
+			return nvml.GpuInstanceProfileInfo{}, nvml.ERROR_NO_PERMISSION
+		},
+	}
+
+	_, ret := nvml.GetMigLayout(device)
+	require.Equal(t, nvml.ERROR_NO_PERMISSION, ret)
+}
+
+func TestGetMigDevices(t *testing.T) {
+	testCases := []struct {
+		description     string
+		maxCount        int
+		maxCountRet     nvml.Return
+		present         map[int]bool
+		invalidFrom     int
+		expectedIndices []int
+		expectedCalls   int
+		expectedRet     nvml.Return
+	}{
+		{
+			description:     "max count supported",
+			maxCount:        4,
+			present:         map[int]bool{0: true, 2: true, 5: true},
+			expectedIndices: []int{0, 2},
+			expectedCalls:   4,
+			expectedRet:     nvml.SUCCESS,
+		},
+		{
+			description:     "max count not implemented",
+			maxCountRet:     nvml.ERROR_FUNCTION_NOT_FOUND,
+			present:         map[int]bool{1: true, 6: true},
+			expectedIndices: []int{1, 6},
+			expectedCalls:   7,
+			expectedRet:     nvml.SUCCESS,
+		},
+		{
+			description:     "fallback bound exceeds the device",
+			maxCountRet:     nvml.ERROR_FUNCTION_NOT_FOUND,
+			present:         map[int]bool{0: true, 2: true},
+			invalidFrom:     3,
+			expectedIndices: []int{0, 2},
+			expectedCalls:   4,
+			expectedRet:     nvml.SUCCESS,
+		},
+		{
+			description:   "invalid index below the driver count",
+			maxCount:      4,
+			present:       map[int]bool{0: true},
+			invalidFrom:   2,
+			expectedCalls: 3,
+			expectedRet:   nvml.ERROR_INVALID_ARGUMENT,
+		},
+		{
+			description: "max count fails",
+			maxCountRet: nvml.ERROR_UNKNOWN,
+			expectedRet: nvml.ERROR_UNKNOWN,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			migDevices := make(map[int]nvml.Device)
+			device := &mock.Device{
+				GetMaxMigDeviceCountFunc: func() (int, nvml.Return) {
+					return tc.maxCount, tc.maxCountRet
+				},
+				GetMigDeviceHandleByIndexFunc: func(index int) (nvml.Device, nvml.Return) {
+					if tc.invalidFrom > 0 && index >= tc.invalidFrom {
+						return nil, nvml.ERROR_INVALID_ARGUMENT
+					}
+					if !tc.present[index] {
+						return nil, nvml.ERROR_NOT_FOUND
+					}
+					migDevice := &mock.Device{}
+					migDevices[index] = migDevice
+					return migDevice, nvml.SUCCESS
+				},
+			}
+
+			devices, ret := nvml.GetMigDevices(device)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Len(t, device.GetMigDeviceHandleByIndexCalls(), tc.expectedCalls)
+			if tc.expectedRet != nvml.SUCCESS {
+				return
+			}
+			var expected []nvml.Device
+			for _, index := range tc.expectedIndices {
+				expected = append(expected, migDevices[index])
+			}
+			require.Equal(t, expected, devices)
+		})
+	}
+}
+
+func TestGetMigDeviceHandleByIndexOpt(t *testing.T) {
+	migDevice := &mock.Device{}
+	device := &mock.Device{
+		GetMigDeviceHandleByIndexFunc: func(index int) (nvml.Device, nvml.Return) {
+			switch {
+			case index == 0:
+				return migDevice, nvml.SUCCESS
+			case index < 7:
+				return nil, nvml.ERROR_NOT_FOUND
+			}
+			return nil, nvml.ERROR_INVALID_ARGUMENT
+		},
+	}
+
+	testCases := []struct {
+		description    string
+		index          int
+		expectedDevice nvml.Device
+		expectedExists bool
+		expectedRet    nvml.Return
+	}{
+		{
+			description:    "populated slot",
+			index:          0,
+			expectedDevice: migDevice,
+			expectedExists: true,
+			expectedRet:    nvml.SUCCESS,
+		},
+		{
+			description: "empty slot",
+			index:       3,
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "invalid index",
+			index:       7,
+			expectedRet: nvml.ERROR_INVALID_ARGUMENT,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			d, exists, ret := nvml.GetMigDeviceHandleByIndexOpt(device, tc.index)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expectedExists, exists)
+			require.Equal(t, tc.expectedDevice, d)
+		})
+	}
+}
@@ -438,7 +438,7 @@ var _ nvml.Device = &Device{}
 //			GetProcessUtilizationFunc: func(v uint64) ([]nvml.ProcessUtilizationSample, nvml.Return) {
 //				panic("mock out the GetProcessUtilization method")
 //			},
-//			GetProcessesUtilizationInfoFunc: func() (nvml.ProcessesUtilizationInfo, nvml.Return) {
+//			GetProcessesUtilizationInfoFunc: func(v uint64) (nvml.ProcessesUtilizationInfo, []nvml.ProcessUtilizationInfo_v1, nvml.Return) {
 //				panic("mock out the GetProcessesUtilizationInfo method")
 //			},
 //			GetRemappedRowsFunc: func() (int, int, bool, bool, nvml.Return) {
@@ -477,10 +477,10 @@ var _ nvml.Device = &Device{}
 //			GetSupportedEventTypesFunc: func() (uint64, nvml.Return) {
 //				panic("mock out the GetSupportedEventTypes method")
 //			},
-//			GetSupportedGraphicsClocksFunc: func(n int) (int, uint32, nvml.Return) {
+//			GetSupportedGraphicsClocksFunc: func(n int) (int, []uint32, nvml.Return) {
 //				panic("mock out the GetSupportedGraphicsClocks method")
 //			},
-//			GetSupportedMemoryClocksFunc: func() (int, uint32, nvml.Return) {
+//			GetSupportedMemoryClocksFunc: func() (int, []uint32, nvml.Return) {
 //				panic("mock out the GetSupportedMemoryClocks method")
 //			},
 //			GetSupportedPerformanceStatesFunc: func() ([]nvml.Pstates, nvml.Return) {
@@ -1127,7 +1127,7 @@ type Device struct {
 	GetProcessUtilizationFunc func(v uint64) ([]nvml.ProcessUtilizationSample, nvml.Return)
 
 	// GetProcessesUtilizationInfoFunc mocks the GetProcessesUtilizationInfo method.
-	GetProcessesUtilizationInfoFunc func() (nvml.ProcessesUtilizationInfo, nvml.Return)
+	GetProcessesUtilizationInfoFunc func(v uint64) (nvml.ProcessesUtilizationInfo, []nvml.ProcessUtilizationInfo_v1, nvml.Return)
 
 	// GetRemappedRowsFunc mocks the GetRemappedRows method.
 	GetRemappedRowsFunc func() (int, int, bool, bool, nvml.Return)
@@ -1166,10 +1166,10 @@ type Device struct {
 	GetSupportedEventTypesFunc func() (uint64, nvml.Return)
 
 	// GetSupportedGraphicsClocksFunc mocks the GetSupportedGraphicsClocks method.
-	GetSupportedGraphicsClocksFunc func(n int) (int, uint32, nvml.Return)
+	GetSupportedGraphicsClocksFunc func(n int) (int, []uint32, nvml.Return)
 
 	// GetSupportedMemoryClocksFunc mocks the GetSupportedMemoryClocks method.
-	GetSupportedMemoryClocksFunc func() (int, uint32, nvml.Return)
+	GetSupportedMemoryClocksFunc func() (int, []uint32, nvml.Return)
 
 	// GetSupportedPerformanceStatesFunc mocks the GetSupportedPerformanceStates method.
 	GetSupportedPerformanceStatesFunc func() ([]nvml.Pstates, nvml.Return)
@@ -1925,6 +1925,8 @@ type Device struct {
 		}
 		// GetProcessesUtilizationInfo holds details about calls to the GetProcessesUtilizationInfo method.
 		GetProcessesUtilizationInfo []struct {
+			// V is the v argument value.
+			V uint64
 		}
 		// GetRemappedRows holds details about calls to the GetRemappedRows method.
 		GetRemappedRows []struct {
@@ -6607,16 +6609,19 @@ func (mock *Device) GetProcessUtilizationCalls() []struct {
 }
 
 // GetProcessesUtilizationInfo calls GetProcessesUtilizationInfoFunc.
-func (mock *Device) GetProcessesUtilizationInfo() (nvml.ProcessesUtilizationInfo, nvml.Return) {
+func (mock *Device) GetProcessesUtilizationInfo(v uint64) (nvml.ProcessesUtilizationInfo, []nvml.ProcessUtilizationInfo_v1, nvml.Return) {
 	if mock.GetProcessesUtilizationInfoFunc == nil {
 		panic("Device.GetProcessesUtilizationInfoFunc: method is nil but Device.GetProcessesUtilizationInfo was just called")
 	}
 	callInfo := struct {
-	}{}
+		V uint64
+	}{
+		V: v,
+	}
 	mock.lockGetProcessesUtilizationInfo.Lock()
 	mock.calls.GetProcessesUtilizationInfo = append(mock.calls.GetProcessesUtilizationInfo, callInfo)
 	mock.lockGetProcessesUtilizationInfo.Unlock()
-	return mock.GetProcessesUtilizationInfoFunc()
+	return mock.GetProcessesUtilizationInfoFunc(v)
 }
 
 // GetProcessesUtilizationInfoCalls gets all the calls that were made to GetProcessesUtilizationInfo.
@@ -6624,8 +6629,10 @@ func (mock *Device) GetProcessesUtilizationInfo() (nvml.ProcessesUtilizationInfo
 //
 //	len(mockedDevice.GetProcessesUtilizationInfoCalls())
 func (mock *Device) GetProcessesUtilizationInfoCalls() []struct {
+	V uint64
 } {
 	var calls []struct {
+		V uint64
 	}
 	mock.lockGetProcessesUtilizationInfo.RLock()
 	calls = mock.calls.GetProcessesUtilizationInfo
@@ -6977,7 +6984,7 @@ func (mock *Device) GetSupportedEventTypesCalls() []struct {
 }
 
 // GetSupportedGraphicsClocks calls GetSupportedGraphicsClocksFunc.
-func (mock *Device) GetSupportedGraphicsClocks(n int) (int, uint32, nvml.Return) {
+func (mock *Device) GetSupportedGraphicsClocks(n int) (int, []uint32, nvml.Return) {
 	if mock.GetSupportedGraphicsClocksFunc == nil {
 		panic("Device.GetSupportedGraphicsClocksFunc: method is nil but Device.GetSupportedGraphicsClocks was just called")
 	}
@@ -7009,7 +7016,7 @@ func (mock *Device) GetSupportedGraphicsClocksCalls() []struct {
 }
 
 // GetSupportedMemoryClocks calls GetSupportedMemoryClocksFunc.
-func (mock *Device) GetSupportedMemoryClocks() (int, uint32, nvml.Return) {
+func (mock *Device) GetSupportedMemoryClocks() (int, []uint32, nvml.Return) {
 	if mock.GetSupportedMemoryClocksFunc == nil {
 		panic("Device.GetSupportedMemoryClocksFunc: method is nil but Device.GetSupportedMemoryClocks was just called")
 	}
@@ -462,7 +462,7 @@ var _ nvml.Interface = &Interface{}
 //			DeviceGetProcessUtilizationFunc: func(device nvml.Device, v uint64) ([]nvml.ProcessUtilizationSample, nvml.Return) {
 //				panic("mock out the DeviceGetProcessUtilization method")
 //			},
-//			DeviceGetProcessesUtilizationInfoFunc: func(device nvml.Device) (nvml.ProcessesUtilizationInfo, nvml.Return) {
+//			DeviceGetProcessesUtilizationInfoFunc: func(device nvml.Device, v uint64) (nvml.ProcessesUtilizationInfo, []nvml.ProcessUtilizationInfo_v1, nvml.Return) {
 //				panic("mock out the DeviceGetProcessesUtilizationInfo method")
 //			},
 //			DeviceGetRemappedRowsFunc: func(device nvml.Device) (int, int, bool, bool, nvml.Return) {
@@ -501,10 +501,10 @@ var _ nvml.Interface = &Interface{}
 //			DeviceGetSupportedEventTypesFunc: func(device nvml.Device) (uint64, nvml.Return) {
 //				panic("mock out the DeviceGetSupportedEventTypes method")
 //			},
-//			DeviceGetSupportedGraphicsClocksFunc: func(device nvml.Device, n int) (int, uint32, nvml.Return) {
+//			DeviceGetSupportedGraphicsClocksFunc: func(device nvml.Device, n int) (int, []uint32, nvml.Return) {
 //				panic("mock out the DeviceGetSupportedGraphicsClocks method")
 //			},
-//			DeviceGetSupportedMemoryClocksFunc: func(device nvml.Device) (int, uint32, nvml.Return) {
+//			DeviceGetSupportedMemoryClocksFunc: func(device nvml.Device) (int, []uint32, nvml.Return) {
 //				panic("mock out the DeviceGetSupportedMemoryClocks method")
 //			},
 //			DeviceGetSupportedPerformanceStatesFunc: func(device nvml.Device) ([]nvml.Pstates, nvml.Return) {
@@ -840,6 +840,9 @@ var _ nvml.Interface = &Interface{}
 //			SystemGetNVMLVersionFunc: func() (string, nvml.Return) {
 //				panic("mock out the SystemGetNVMLVersion method")
 //			},
+//			SystemGetNvlinkBwModeFunc: func() (uint32, nvml.Return) {
+//				panic("mock out the SystemGetNvlinkBwMode method")
+//			},
 //			SystemGetProcessNameFunc: func(n int) (string, nvml.Return) {
 //				panic("mock out the SystemGetProcessName method")
 //			},
@@ -849,6 +852,9 @@ var _ nvml.Interface = &Interface{}
 //			SystemSetConfComputeKeyRotationThresholdInfoFunc: func(confComputeSetKeyRotationThresholdInfo nvml.ConfComputeSetKeyRotationThresholdInfo) nvml.Return {
 //				panic("mock out the SystemSetConfComputeKeyRotationThresholdInfo method")
 //			},
+//			SystemSetNvlinkBwModeFunc: func(v uint32) nvml.Return {
+//				panic("mock out the SystemSetNvlinkBwMode method")
+//			},
 //			UnitGetCountFunc: func() (int, nvml.Return) {
 //				panic("mock out the UnitGetCount method")
 //			},
@@ -936,6 +942,9 @@ var _ nvml.Interface = &Interface{}
 //			VgpuInstanceGetUUIDFunc: func(vgpuInstance nvml.VgpuInstance) (string, nvml.Return) {
 //				panic("mock out the VgpuInstanceGetUUID method")
 //			},
+//			VgpuInstanceGetUtilizationFunc: func(vgpuInstance nvml.VgpuInstance, v uint64) (nvml.ValueType, nvml.VgpuInstanceUtilizationSample, nvml.Return) {
+//				panic("mock out the VgpuInstanceGetUtilization method")
+//			},
 //			VgpuInstanceGetVmDriverVersionFunc: func(vgpuInstance nvml.VgpuInstance) (string, nvml.Return) {
 //				panic("mock out the VgpuInstanceGetVmDriverVersion method")
 //			},
@@ -1433,7 +1442,7 @@ type Interface struct {
 	DeviceGetProcessUtilizationFunc func(device nvml.Device, v uint64) ([]nvml.ProcessUtilizationSample, nvml.Return)
 
 	// DeviceGetProcessesUtilizationInfoFunc mocks the DeviceGetProcessesUtilizationInfo method.
-	DeviceGetProcessesUtilizationInfoFunc func(device nvml.Device) (nvml.ProcessesUtilizationInfo, nvml.Return)
+	DeviceGetProcessesUtilizationInfoFunc func(device nvml.Device, v uint64) (nvml.ProcessesUtilizationInfo, []nvml.ProcessUtilizationInfo_v1, nvml.Return)
 
 	// DeviceGetRemappedRowsFunc mocks the DeviceGetRemappedRows method.
 	DeviceGetRemappedRowsFunc func(device nvml.Device) (int, int, bool, bool, nvml.Return)
@@ -1472,10 +1481,10 @@ type Interface struct {
 	DeviceGetSupportedEventTypesFunc func(device nvml.Device) (uint64, nvml.Return)
 
 	// DeviceGetSupportedGraphicsClocksFunc mocks the DeviceGetSupportedGraphicsClocks method.
-	DeviceGetSupportedGraphicsClocksFunc func(device nvml.Device, n int) (int, uint32, nvml.Return)
+	DeviceGetSupportedGraphicsClocksFunc func(device nvml.Device, n int) (int, []uint32, nvml.Return)
 
 	// DeviceGetSupportedMemoryClocksFunc mocks the DeviceGetSupportedMemoryClocks method.
-	DeviceGetSupportedMemoryClocksFunc func(device nvml.Device) (int, uint32, nvml.Return)
+	DeviceGetSupportedMemoryClocksFunc func(device nvml.Device) (int, []uint32, nvml.Return)
 
 	// DeviceGetSupportedPerformanceStatesFunc mocks the DeviceGetSupportedPerformanceStates method.
 	DeviceGetSupportedPerformanceStatesFunc func(device nvml.Device) ([]nvml.Pstates, nvml.Return)
@@ -1810,6 +1819,9 @@ type Interface struct {
 	// SystemGetNVMLVersionFunc mocks the SystemGetNVMLVersion method.
 	SystemGetNVMLVersionFunc func() (string, nvml.Return)
 
+	// SystemGetNvlinkBwModeFunc mocks the SystemGetNvlinkBwMode method.
+	SystemGetNvlinkBwModeFunc func() (uint32, nvml.Return)
+
 	// SystemGetProcessNameFunc mocks the SystemGetProcessName method.
 	SystemGetProcessNameFunc func(n int) (string, nvml.Return)
 
@@ -1819,6 +1831,9 @@ type Interface struct {
 	// SystemSetConfComputeKeyRotationThresholdInfoFunc mocks the SystemSetConfComputeKeyRotationThresholdInfo method.
 	SystemSetConfComputeKeyRotationThresholdInfoFunc func(confComputeSetKeyRotationThresholdInfo nvml.ConfComputeSetKeyRotationThresholdInfo) nvml.Return
 
+	// SystemSetNvlinkBwModeFunc mocks the SystemSetNvlinkBwMode method.
+	SystemSetNvlinkBwModeFunc func(v uint32) nvml.Return
+
 	// UnitGetCountFunc mocks the UnitGetCount method.
 	UnitGetCountFunc func() (int, nvml.Return)
 
@@ -1906,6 +1921,9 @@ type Interface struct {
 	// VgpuInstanceGetUUIDFunc mocks the VgpuInstanceGetUUID method.
 	VgpuInstanceGetUUIDFunc func(vgpuInstance nvml.VgpuInstance) (string, nvml.Return)
 
+	// VgpuInstanceGetUtilizationFunc mocks the VgpuInstanceGetUtilization method.
+	VgpuInstanceGetUtilizationFunc func(vgpuInstance nvml.VgpuInstance, v uint64) (nvml.ValueType, nvml.VgpuInstanceUtilizationSample, nvml.Return)
+
 	// VgpuInstanceGetVmDriverVersionFunc mocks the VgpuInstanceGetVmDriverVersion method.
 	VgpuInstanceGetVmDriverVersionFunc func(vgpuInstance nvml.VgpuInstance) (string, nvml.Return)
 
@@ -2807,6 +2825,8 @@ type Interface struct {
 		DeviceGetProcessesUtilizationInfo []struct {
 			// Device is the device argument value.
 			Device nvml.Device
+			// V is the v argument value.
+			V uint64
 		}
 		// DeviceGetRemappedRows holds details about calls to the DeviceGetRemappedRows method.
 		DeviceGetRemappedRows []struct {
@@ -3575,6 +3595,9 @@ type Interface struct {
 		// SystemGetNVMLVersion holds details about calls to the SystemGetNVMLVersion method.
 		SystemGetNVMLVersion []struct {
 		}
+		// SystemGetNvlinkBwMode holds details about calls to the SystemGetNvlinkBwMode method.
+		SystemGetNvlinkBwMode []struct {
+		}
 		// SystemGetProcessName holds details about calls to the SystemGetProcessName method.
 		SystemGetProcessName []struct {
 			// N is the n argument value.
@@ -3590,6 +3613,11 @@ type Interface struct {
 			// ConfComputeSetKeyRotationThresholdInfo is the confComputeSetKeyRotationThresholdInfo argument value.
 			ConfComputeSetKeyRotationThresholdInfo nvml.ConfComputeSetKeyRotationThresholdInfo
 		}
+		// SystemSetNvlinkBwMode holds details about calls to the SystemSetNvlinkBwMode method.
+		SystemSetNvlinkBwMode []struct {
+			// V is the v argument value.
+			V uint32
+		}
 		// UnitGetCount holds details about calls to the UnitGetCount method.
 		UnitGetCount []struct {
 		}
@@ -3739,6 +3767,13 @@ type Interface struct {
 			// VgpuInstance is the vgpuInstance argument value.
 			VgpuInstance nvml.VgpuInstance
 		}
+		// VgpuInstanceGetUtilization holds details about calls to the VgpuInstanceGetUtilization method.
+		VgpuInstanceGetUtilization []struct {
+			// VgpuInstance is the vgpuInstance argument value.
+			VgpuInstance nvml.VgpuInstance
+			// V is the v argument value.
+			V uint64
+		}
 		// VgpuInstanceGetVmDriverVersion holds details about calls to the VgpuInstanceGetVmDriverVersion method.
 		VgpuInstanceGetVmDriverVersion []struct {
 			// VgpuInstance is the vgpuInstance argument value.
@@ -4097,9 +4132,11 @@ type Interface struct {
 	lockSystemGetDriverVersion                          sync.RWMutex
 	lockSystemGetHicVersion                             sync.RWMutex
 	lockSystemGetNVMLVersion                            sync.RWMutex
+	lockSystemGetNvlinkBwMode                           sync.RWMutex
 	lockSystemGetProcessName                            sync.RWMutex
 	lockSystemGetTopologyGpuSet                         sync.RWMutex
 	lockSystemSetConfComputeKeyRotationThresholdInfo    sync.RWMutex
+	lockSystemSetNvlinkBwMode                           sync.RWMutex
 	lockUnitGetCount                                    sync.RWMutex
 	lockUnitGetDevices                                  sync.RWMutex
 	lockUnitGetFanSpeedInfo                             sync.RWMutex
@@ -4129,6 +4166,7 @@ type Interface struct {
 	lockVgpuInstanceGetMetadata                         sync.RWMutex
 	lockVgpuInstanceGetType                             sync.RWMutex
 	lockVgpuInstanceGetUUID                             sync.RWMutex
+	lockVgpuInstanceGetUtilization                      sync.RWMutex
 	lockVgpuInstanceGetVmDriverVersion                  sync.RWMutex
 	lockVgpuInstanceGetVmID                             sync.RWMutex
 	lockVgpuInstanceSetEncoderCapacity                  sync.RWMutex
@@ -9101,19 +9139,21 @@ func (mock *Interface) DeviceGetProcessUtilizationCalls() []struct {
 }
 
 // DeviceGetProcessesUtilizationInfo calls DeviceGetProcessesUtilizationInfoFunc.
-func (mock *Interface) DeviceGetProcessesUtilizationInfo(device nvml.Device) (nvml.ProcessesUtilizationInfo, nvml.Return) {
+func (mock *Interface) DeviceGetProcessesUtilizationInfo(device nvml.Device, v uint64) (nvml.ProcessesUtilizationInfo, []nvml.ProcessUtilizationInfo_v1, nvml.Return) {
 	if mock.DeviceGetProcessesUtilizationInfoFunc == nil {
 		panic("Interface.DeviceGetProcessesUtilizationInfoFunc: method is nil but Interface.DeviceGetProcessesUtilizationInfo was just called")
 	}
 	callInfo := struct {
 		Device nvml.Device
+		V      uint64
 	}{
 		Device: device,
+		V:      v,
 	}
 	mock.lockDeviceGetProcessesUtilizationInfo.Lock()
 	mock.calls.DeviceGetProcessesUtilizationInfo = append(mock.calls.DeviceGetProcessesUtilizationInfo, callInfo)
 	mock.lockDeviceGetProcessesUtilizationInfo.Unlock()
-	return mock.DeviceGetProcessesUtilizationInfoFunc(device)
+	return mock.DeviceGetProcessesUtilizationInfoFunc(device, v)
 }
 
 // DeviceGetProcessesUtilizationInfoCalls gets all the calls that were made to DeviceGetProcessesUtilizationInfo.
@@ -9122,9 +9162,11 @@ func (mock *Interface) DeviceGetProcessesUtilizationInfo(device nvml.Device) (nv
 //	len(mockedInterface.DeviceGetProcessesUtilizationInfoCalls())
 func (mock *Interface) DeviceGetProcessesUtilizationInfoCalls() []struct {
 	Device nvml.Device
+	V      uint64
 } {
 	var calls []struct {
 		Device nvml.Device
+		V      uint64
 	}
 	mock.lockDeviceGetProcessesUtilizationInfo.RLock()
 	calls = mock.calls.DeviceGetProcessesUtilizationInfo
@@ -9533,7 +9575,7 @@ func (mock *Interface) DeviceGetSupportedEventTypesCalls() []struct {
 }
 
 // DeviceGetSupportedGraphicsClocks calls DeviceGetSupportedGraphicsClocksFunc.
-func (mock *Interface) DeviceGetSupportedGraphicsClocks(device nvml.Device, n int) (int, uint32, nvml.Return) {
+func (mock *Interface) DeviceGetSupportedGraphicsClocks(device nvml.Device, n int) (int, []uint32, nvml.Return) {
 	if mock.DeviceGetSupportedGraphicsClocksFunc == nil {
 		panic("Interface.DeviceGetSupportedGraphicsClocksFunc: method is nil but Interface.DeviceGetSupportedGraphicsClocks was just called")
 	}
@@ -9569,7 +9611,7 @@ func (mock *Interface) DeviceGetSupportedGraphicsClocksCalls() []struct {
 }
 
 // DeviceGetSupportedMemoryClocks calls DeviceGetSupportedMemoryClocksFunc.
-func (mock *Interface) DeviceGetSupportedMemoryClocks(device nvml.Device) (int, uint32, nvml.Return) {
+func (mock *Interface) DeviceGetSupportedMemoryClocks(device nvml.Device) (int, []uint32, nvml.Return) {
 	if mock.DeviceGetSupportedMemoryClocksFunc == nil {
 		panic("Interface.DeviceGetSupportedMemoryClocksFunc: method is nil but Interface.DeviceGetSupportedMemoryClocks was just called")
 	}
@@ -13401,6 +13443,33 @@ func (mock *Interface) SystemGetNVMLVersionCalls() []struct {
 	return calls
 }
 
+// SystemGetNvlinkBwMode calls SystemGetNvlinkBwModeFunc.
+func (mock *Interface) SystemGetNvlinkBwMode() (uint32, nvml.Return) {
+	if mock.SystemGetNvlinkBwModeFunc == nil {
+		panic("Interface.SystemGetNvlinkBwModeFunc: method is nil but Interface.SystemGetNvlinkBwMode was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockSystemGetNvlinkBwMode.Lock()
+	mock.calls.SystemGetNvlinkBwMode = append(mock.calls.SystemGetNvlinkBwMode, callInfo)
+	mock.lockSystemGetNvlinkBwMode.Unlock()
+	return mock.SystemGetNvlinkBwModeFunc()
+}
+
+// SystemGetNvlinkBwModeCalls gets all the calls that were made to SystemGetNvlinkBwMode.
+// Check the length with:
+//
+//	len(mockedInterface.SystemGetNvlinkBwModeCalls())
+func (mock *Interface) SystemGetNvlinkBwModeCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockSystemGetNvlinkBwMode.RLock()
+	calls = mock.calls.SystemGetNvlinkBwMode
+	mock.lockSystemGetNvlinkBwMode.RUnlock()
+	return calls
+}
+
 // SystemGetProcessName calls SystemGetProcessNameFunc.
 func (mock *Interface) SystemGetProcessName(n int) (string, nvml.Return) {
 	if mock.SystemGetProcessNameFunc == nil {
@@ -13497,6 +13566,38 @@ func (mock *Interface) SystemSetConfComputeKeyRotationThresholdInfoCalls() []str
 	return calls
 }
 
+// SystemSetNvlinkBwMode calls SystemSetNvlinkBwModeFunc.
+func (mock *Interface) SystemSetNvlinkBwMode(v uint32) nvml.Return {
+	if mock.SystemSetNvlinkBwModeFunc == nil {
+		panic("Interface.SystemSetNvlinkBwModeFunc: method is nil but Interface.SystemSetNvlinkBwMode was just called")
+	}
+	callInfo := struct {
+		V uint32
+	}{
+		V: v,
+	}
+	mock.lockSystemSetNvlinkBwMode.Lock()
+	mock.calls.SystemSetNvlinkBwMode = append(mock.calls.SystemSetNvlinkBwMode, callInfo)
+	mock.lockSystemSetNvlinkBwMode.Unlock()
+	return mock.SystemSetNvlinkBwModeFunc(v)
+}
+
+// SystemSetNvlinkBwModeCalls gets all the calls that were made to SystemSetNvlinkBwMode.
+// Check the length with:
+//
+//	len(mockedInterface.SystemSetNvlinkBwModeCalls())
+func (mock *Interface) SystemSetNvlinkBwModeCalls() []struct {
+	V uint32
+} {
+	var calls []struct {
+		V uint32
+	}
+	mock.lockSystemSetNvlinkBwMode.RLock()
+	calls = mock.calls.SystemSetNvlinkBwMode
+	mock.lockSystemSetNvlinkBwMode.RUnlock()
+	return calls
+}
+
 // UnitGetCount calls UnitGetCountFunc.
 func (mock *Interface) UnitGetCount() (int, nvml.Return) {
 	if mock.UnitGetCountFunc == nil {
@@ -14432,6 +14533,42 @@ func (mock *Interface) VgpuInstanceGetUUIDCalls() []struct {
 	return calls
 }
 
+// VgpuInstanceGetUtilization calls VgpuInstanceGetUtilizationFunc.
+func (mock *Interface) VgpuInstanceGetUtilization(vgpuInstance nvml.VgpuInstance, v uint64) (nvml.ValueType, nvml.VgpuInstanceUtilizationSample, nvml.Return) {
+	if mock.VgpuInstanceGetUtilizationFunc == nil {
+		panic("Interface.VgpuInstanceGetUtilizationFunc: method is nil but Interface.VgpuInstanceGetUtilization was just called")
+	}
+	callInfo := struct {
+		VgpuInstance nvml.VgpuInstance
+		V            uint64
+	}{
+		VgpuInstance: vgpuInstance,
+		V:            v,
+	}
+	mock.lockVgpuInstanceGetUtilization.Lock()
+	mock.calls.VgpuInstanceGetUtilization = append(mock.calls.VgpuInstanceGetUtilization, callInfo)
+	mock.lockVgpuInstanceGetUtilization.Unlock()
+	return mock.VgpuInstanceGetUtilizationFunc(vgpuInstance, v)
+}
+
+// VgpuInstanceGetUtilizationCalls gets all the calls that were made to VgpuInstanceGetUtilization.
+// Check the length with:
+//
+//	len(mockedInterface.VgpuInstanceGetUtilizationCalls())
+func (mock *Interface) VgpuInstanceGetUtilizationCalls() []struct {
+	VgpuInstance nvml.VgpuInstance
+	V            uint64
+} {
+	var calls []struct {
+		VgpuInstance nvml.VgpuInstance
+		V            uint64
+	}
+	mock.lockVgpuInstanceGetUtilization.RLock()
+	calls = mock.calls.VgpuInstanceGetUtilization
+	mock.lockVgpuInstanceGetUtilization.RUnlock()
+	return calls
+}
+
 // VgpuInstanceGetVmDriverVersion calls VgpuInstanceGetVmDriverVersionFunc.
 func (mock *Interface) VgpuInstanceGetVmDriverVersion(vgpuInstance nvml.VgpuInstance) (string, nvml.Return) {
 	if mock.VgpuInstanceGetVmDriverVersionFunc == nil {
@@ -78,6 +78,9 @@ var _ nvml.VgpuInstance = &VgpuInstance{}
 //			GetUUIDFunc: func() (string, nvml.Return) {
 //				panic("mock out the GetUUID method")
 //			},
+//			GetUtilizationFunc: func(v uint64) (nvml.ValueType, nvml.VgpuInstanceUtilizationSample, nvml.Return) {
+//				panic("mock out the GetUtilization method")
+//			},
 //			GetVmDriverVersionFunc: func() (string, nvml.Return) {
 //				panic("mock out the GetVmDriverVersion method")
 //			},
@@ -154,6 +157,9 @@ type VgpuInstance struct {
 	// GetUUIDFunc mocks the GetUUID method.
 	GetUUIDFunc func() (string, nvml.Return)
 
+	// GetUtilizationFunc mocks the GetUtilization method.
+	GetUtilizationFunc func(v uint64) (nvml.ValueType, nvml.VgpuInstanceUtilizationSample, nvml.Return)
+
 	// GetVmDriverVersionFunc mocks the GetVmDriverVersion method.
 	GetVmDriverVersionFunc func() (string, nvml.Return)
 
@@ -227,6 +233,11 @@ type VgpuInstance struct {
 		// GetUUID holds details about calls to the GetUUID method.
 		GetUUID []struct {
 		}
+		// GetUtilization holds details about calls to the GetUtilization method.
+		GetUtilization []struct {
+			// V is the v argument value.
+			V uint64
+		}
 		// GetVmDriverVersion holds details about calls to the GetVmDriverVersion method.
 		GetVmDriverVersion []struct {
 		}
@@ -259,6 +270,7 @@ type VgpuInstance struct {
 	lockGetMetadata         sync.RWMutex
 	lockGetType             sync.RWMutex
 	lockGetUUID             sync.RWMutex
+	lockGetUtilization      sync.RWMutex
 	lockGetVmDriverVersion  sync.RWMutex
 	lockGetVmID             sync.RWMutex
 	lockSetEncoderCapacity  sync.RWMutex
@@ -809,6 +821,38 @@ func (mock *VgpuInstance) GetUUIDCalls() []struct {
 	return calls
 }
 
+// GetUtilization calls GetUtilizationFunc.
+func (mock *VgpuInstance) GetUtilization(v uint64) (nvml.ValueType, nvml.VgpuInstanceUtilizationSample, nvml.Return) {
+	if mock.GetUtilizationFunc == nil {
+		panic("VgpuInstance.GetUtilizationFunc: method is nil but VgpuInstance.GetUtilization was just called")
+	}
+	callInfo := struct {
+		V uint64
+	}{
+		V: v,
+	}
+	mock.lockGetUtilization.Lock()
+	mock.calls.GetUtilization = append(mock.calls.GetUtilization, callInfo)
+	mock.lockGetUtilization.Unlock()
+	return mock.GetUtilizationFunc(v)
+}
+
+// GetUtilizationCalls gets all the calls that were made to GetUtilization.
+// Check the length with:
+//
+//	len(mockedVgpuInstance.GetUtilizationCalls())
+func (mock *VgpuInstance) GetUtilizationCalls() []struct {
+	V uint64
+} {
+	var calls []struct {
+		V uint64
+	}
+	mock.lockGetUtilization.RLock()
+	calls = mock.calls.GetUtilization
+	mock.lockGetUtilization.RUnlock()
+	return calls
+}
+
 // GetVmDriverVersion calls GetVmDriverVersionFunc.
 func (mock *VgpuInstance) GetVmDriverVersion() (string, nvml.Return) {
 	if mock.GetVmDriverVersionFunc == nil {
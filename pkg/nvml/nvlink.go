@@ -0,0 +1,145 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+// NvLinkStatus summarizes the health of an NVLink. The version and error
+// counters are only read for active links and are zero otherwise.
+type NvLinkStatus struct {
+	Link int
+	// Active reports whether GetNvLinkState returned FEATURE_ENABLED.
+	Active  bool
+	Version uint32
+	// The error counters of the link. Counters that the link does not
+	// support are reported as zero.
+	ReplayErrors   uint64
+	RecoveryErrors uint64
+	CrcFlitErrors  uint64
+	CrcDataErrors  uint64
+}
+
+// NvLinkHealth returns the status of each NVLink of a device, in link order.
+// Links whose state cannot be queried are skipped, so devices without NVLink
+// return an empty slice. Any error other than an unsupported query is
+// returned.
+func NvLinkHealth(device Device) ([]NvLinkStatus, Return) {
+	statuses := []NvLinkStatus{}
+	for link := 0; link < NVLINK_MAX_LINKS; link++ {
+		state, ret := device.GetNvLinkState(link)
+		// Links beyond those present on the device are reported as invalid.
+		if ret == ERROR_INVALID_ARGUMENT {
+			break
+		}
+		if isUnsupported(ret) {
+			continue
+		}
+		if ret != SUCCESS {
+			return nil, ret
+		}
+		status := NvLinkStatus{Link: link, Active: state == FEATURE_ENABLED}
+		if !status.Active {
+			statuses = append(statuses, status)
+			continue
+		}
+		if status.Version, ret = device.GetNvLinkVersion(link); ret != SUCCESS {
+			return nil, ret
+		}
+
+		counters := []struct {
+			counter NvLinkErrorCounter
+			value   *uint64
+		}{
+			{NVLINK_ERROR_DL_REPLAY, &status.ReplayErrors},
+			{NVLINK_ERROR_DL_RECOVERY, &status.RecoveryErrors},
+			{NVLINK_ERROR_DL_CRC_FLIT, &status.CrcFlitErrors},
+			{NVLINK_ERROR_DL_CRC_DATA, &status.CrcDataErrors},
+		}
+		for _, c := range counters {
+			value, ret := device.GetNvLinkErrorCounter(link, c.counter)
+			if isUnsupported(ret) {
+				continue
+			}
+			if ret != SUCCESS {
+				return nil, ret
+			}
+			*c.value = value
+		}
+
+		statuses = append(statuses, status)
+	}
+	return statuses, SUCCESS
+}
+
+// nvLinkAllLinksScopeId is the scope id that requests the value of an NVLink
+// field value summed up across all links.
+const nvLinkAllLinksScopeId = ^uint32(0)
+
+// NvLinkCounter is a cumulative NVLink traffic counter. Available is false if
+// the device does not report the counter.
+type NvLinkCounter struct {
+	Bytes     uint64
+	Available bool
+}
+
+// NvLinkThroughput holds the NVLink traffic counters of a device, summed up
+// across all links. The data counters only cover payload, while the raw
+// counters include protocol overhead.
+type NvLinkThroughput struct {
+	DataTx NvLinkCounter
+	DataRx NvLinkCounter
+	RawTx  NvLinkCounter
+	RawRx  NvLinkCounter
+}
+
+// GetNvLinkAggregateThroughput reads the NVLink throughput counters of a
+// device for all links in a single GetFieldValues call, instead of querying
+// each link in turn. NVML reports the counters in KiB; they are converted to
+// bytes here. Counters that are not supported are marked as unavailable. If
+// the call as a whole fails, its error is returned.
+func GetNvLinkAggregateThroughput(device Device) (NvLinkThroughput, Return) {
+	var throughput NvLinkThroughput
+	counters := []struct {
+		id      uint32
+		counter *NvLinkCounter
+	}{
+		{FI_DEV_NVLINK_THROUGHPUT_DATA_TX, &throughput.DataTx},
+		{FI_DEV_NVLINK_THROUGHPUT_DATA_RX, &throughput.DataRx},
+		{FI_DEV_NVLINK_THROUGHPUT_RAW_TX, &throughput.RawTx},
+		{FI_DEV_NVLINK_THROUGHPUT_RAW_RX, &throughput.RawRx},
+	}
+
+	values := make([]FieldValue, len(counters))
+	for i, c := range counters {
+		values[i].FieldId = c.id
+		values[i].ScopeId = nvLinkAllLinksScopeId
+	}
+
+	if ret := device.GetFieldValues(values); ret != SUCCESS {
+		return throughput, ret
+	}
+
+	for i, c := range counters {
+		if Return(values[i].NvmlReturn) != SUCCESS {
+			continue
+		}
+		kib, ok := values[i].AsUint64()
+		if !ok {
+			continue
+		}
+		*c.counter = NvLinkCounter{Bytes: kib * 1024, Available: true}
+	}
+	return throughput, SUCCESS
+}
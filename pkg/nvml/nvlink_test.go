@@ -0,0 +1,117 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestNvLinkHealth(t *testing.T) {
+	const presentLinks = 4
+	active := map[int]bool{0: true, 2: true}
+	device := &mock.Device{
+		GetNvLinkStateFunc: func(link int) (nvml.EnableState, nvml.Return) {
+			if link >= presentLinks {
+				return nvml.FEATURE_DISABLED, nvml.ERROR_INVALID_ARGUMENT
+			}
+			if link == 3 {
+				return nvml.FEATURE_DISABLED, nvml.ERROR_NOT_SUPPORTED
+			}
+			return nvml.BoolToEnableState(active[link]), nvml.SUCCESS
+		},
+		GetNvLinkVersionFunc: func(link int) (uint32, nvml.Return) {
+			return 4, nvml.SUCCESS
+		},
+		GetNvLinkErrorCounterFunc: func(link int, counter nvml.NvLinkErrorCounter) (uint64, nvml.Return) {
+			switch {
+			case link == 2 && counter == nvml.NVLINK_ERROR_DL_REPLAY:
+				return 7, nvml.SUCCESS
+			case link == 2 && counter == nvml.NVLINK_ERROR_DL_CRC_FLIT:
+				return 3, nvml.SUCCESS
+			case counter == nvml.NVLINK_ERROR_DL_CRC_DATA:
+				return 0, nvml.ERROR_NOT_SUPPORTED
+			}
+			return 0, nvml.SUCCESS
+		},
+	}
+
+	statuses, ret := nvml.NvLinkHealth(device)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []nvml.NvLinkStatus{
+		{Link: 0, Active: true, Version: 4},
+		{Link: 1},
+		{Link: 2, Active: true, Version: 4, ReplayErrors: 7, CrcFlitErrors: 3},
+	}, statuses)
+	require.Len(t, device.GetNvLinkStateCalls(), presentLinks+1)
+	require.Len(t, device.GetNvLinkVersionCalls(), len(active))
+
+	device.GetNvLinkVersionFunc = func(link int) (uint32, nvml.Return) {
+		return 0, nvml.ERROR_GPU_IS_LOST
+	}
+	_, ret = nvml.NvLinkHealth(device)
+	require.Equal(t, nvml.ERROR_GPU_IS_LOST, ret)
+}
+
+func TestGetNvLinkAggregateThroughput(t *testing.T) {
+	device := &mock.Device{
+		GetFieldValuesFunc: func(values []nvml.FieldValue) nvml.Return {
+			for i := range values {
+				require.Equal(t, ^uint32(0), values[i].ScopeId)
+				switch values[i].FieldId {
+				case nvml.FI_DEV_NVLINK_THROUGHPUT_DATA_TX:
+					values[i].ValueType = uint32(nvml.VALUE_TYPE_UNSIGNED_LONG_LONG)
+					binary.LittleEndian.PutUint64(values[i].Value[:], 1000)
+				case nvml.FI_DEV_NVLINK_THROUGHPUT_DATA_RX:
+					values[i].ValueType = uint32(nvml.VALUE_TYPE_UNSIGNED_LONG_LONG)
+					binary.LittleEndian.PutUint64(values[i].Value[:], 2000)
+				case nvml.FI_DEV_NVLINK_THROUGHPUT_RAW_TX:
+					values[i].ValueType = uint32(nvml.VALUE_TYPE_UNSIGNED_INT)
+					binary.LittleEndian.PutUint32(values[i].Value[:], 3)
+				default:
+					values[i].NvmlReturn = uint32(nvml.ERROR_NOT_SUPPORTED)
+				}
+			}
+			return nvml.SUCCESS
+		},
+	}
+
+	throughput, ret := nvml.GetNvLinkAggregateThroughput(device)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.NvLinkThroughput{
+		DataTx: nvml.NvLinkCounter{Bytes: 1000 * 1024, Available: true},
+		DataRx: nvml.NvLinkCounter{Bytes: 2000 * 1024, Available: true},
+		RawTx:  nvml.NvLinkCounter{Bytes: 3 * 1024, Available: true},
+	}, throughput)
+	require.Len(t, device.GetFieldValuesCalls(), 1)
+}
+
+func TestGetNvLinkAggregateThroughputError(t *testing.T) {
+	device := &mock.Device{
+		GetFieldValuesFunc: func(values []nvml.FieldValue) nvml.Return {
+			return nvml.ERROR_NOT_SUPPORTED
+		},
+	}
+
+	_, ret := nvml.GetNvLinkAggregateThroughput(device)
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+}
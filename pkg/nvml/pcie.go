@@ -0,0 +1,52 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+// PcieLinkGenerations holds the PCIe link generations of a device.
+type PcieLinkGenerations struct {
+	// Current is the currently negotiated generation.
+	Current int
+	// Max is the maximum generation possible with the GPU and the slot it is
+	// installed in.
+	Max int
+	// GpuMax is the maximum generation supported by the GPU itself.
+	GpuMax int
+}
+
+// SlotLimited reports whether the link is limited below the capability of the
+// GPU by the system it is installed in.
+func (g PcieLinkGenerations) SlotLimited() bool {
+	return g.Max < g.GpuMax
+}
+
+// GetPcieLinkGenerations reads the current, maximum and GPU maximum PCIe link
+// generations of a device.
+func GetPcieLinkGenerations(device Device) (PcieLinkGenerations, Return) {
+	var generations PcieLinkGenerations
+	var ret Return
+
+	if generations.Current, ret = device.GetCurrPcieLinkGeneration(); ret != SUCCESS {
+		return generations, ret
+	}
+	if generations.Max, ret = device.GetMaxPcieLinkGeneration(); ret != SUCCESS {
+		return generations, ret
+	}
+	if generations.GpuMax, ret = device.GetGpuMaxPcieLinkGeneration(); ret != SUCCESS {
+		return generations, ret
+	}
+	return generations, SUCCESS
+}
@@ -0,0 +1,65 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestGetPcieLinkGenerations(t *testing.T) {
+	testCases := []struct {
+		description         string
+		current             int
+		max                 int
+		gpuMax              int
+		expectedSlotLimited bool
+	}{
+		{"GPU limited", 4, 4, 4, false},
+		{"slot limited", 3, 3, 5, true},
+		{"downtrained to save power", 1, 5, 5, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			device := &mock.Device{
+				GetCurrPcieLinkGenerationFunc: func() (int, nvml.Return) {
+					return tc.current, nvml.SUCCESS
+				},
+				GetMaxPcieLinkGenerationFunc: func() (int, nvml.Return) {
+					return tc.max, nvml.SUCCESS
+				},
+				GetGpuMaxPcieLinkGenerationFunc: func() (int, nvml.Return) {
+					return tc.gpuMax, nvml.SUCCESS
+				},
+			}
+
+			generations, ret := nvml.GetPcieLinkGenerations(device)
+			require.Equal(t, nvml.SUCCESS, ret)
+			require.Equal(t, nvml.PcieLinkGenerations{
+				Current: tc.current,
+				Max:     tc.max,
+				GpuMax:  tc.gpuMax,
+			}, generations)
+			require.Equal(t, tc.expectedSlotLimited, generations.SlotLimited())
+		})
+	}
+}
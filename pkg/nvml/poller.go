@@ -0,0 +1,92 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+// ProcessUtilizationPoller reads the per-process utilization samples of a
+// device, keeping track of the last seen timestamp so that each call to Poll
+// returns only samples that have not been returned before.
+type ProcessUtilizationPoller struct {
+	device            Device
+	lastSeenTimestamp uint64
+}
+
+// NewProcessUtilizationPoller creates a ProcessUtilizationPoller for device.
+// The first call to Poll returns all samples in the driver's buffer.
+func NewProcessUtilizationPoller(device Device) *ProcessUtilizationPoller {
+	return &ProcessUtilizationPoller{
+		device: device,
+	}
+}
+
+// Poll returns the samples recorded since the previous call. An empty slice is
+// returned if no new samples are available.
+//
+// If every sample in the driver's buffer is older than the last seen
+// timestamp, the timestamps have gone backwards, e.g. because the driver was
+// restarted. Since the driver filters samples by the last seen timestamp, it
+// reports ERROR_NOT_FOUND in that case; the poller then queries the whole
+// buffer to tell a restart from an idle device. After a restart the poller
+// resets and returns the buffered samples as new.
+func (p *ProcessUtilizationPoller) Poll() ([]ProcessUtilizationSample, Return) {
+	samples, ret := p.device.GetProcessUtilization(p.lastSeenTimestamp)
+	if ret == ERROR_NOT_FOUND && p.lastSeenTimestamp != 0 {
+		samples, ret = p.device.GetProcessUtilization(0)
+	}
+	if ret == ERROR_NOT_FOUND {
+		return []ProcessUtilizationSample{}, SUCCESS
+	}
+	if ret != SUCCESS {
+		return nil, ret
+	}
+	if len(samples) == 0 {
+		return []ProcessUtilizationSample{}, SUCCESS
+	}
+
+	newest := newestTimestamp(samples)
+	if newest < p.lastSeenTimestamp {
+		p.lastSeenTimestamp = newest
+		return samples, SUCCESS
+	}
+
+	// The driver already filters by timestamp, but samples at the boundary
+	// are dropped here to guarantee that none is returned twice.
+	fresh := []ProcessUtilizationSample{}
+	for _, sample := range samples {
+		if sample.TimeStamp > p.lastSeenTimestamp {
+			fresh = append(fresh, sample)
+		}
+	}
+	p.lastSeenTimestamp = newest
+	return fresh, SUCCESS
+}
+
+// Reset clears the last seen timestamp so that the next call to Poll returns
+// all samples in the driver's buffer.
+func (p *ProcessUtilizationPoller) Reset() {
+	p.lastSeenTimestamp = 0
+}
+
+// newestTimestamp returns the timestamp of the most recent sample.
+func newestTimestamp(samples []ProcessUtilizationSample) uint64 {
+	var newest uint64
+	for _, sample := range samples {
+		if sample.TimeStamp > newest {
+			newest = sample.TimeStamp
+		}
+	}
+	return newest
+}
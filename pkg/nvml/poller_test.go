@@ -0,0 +1,126 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestProcessUtilizationPoller(t *testing.T) {
+	var buffer []nvml.ProcessUtilizationSample
+	var requested []uint64
+	device := &mock.Device{
+		GetProcessUtilizationFunc: func(lastSeenTimestamp uint64) ([]nvml.ProcessUtilizationSample, nvml.Return) {
+			requested = append(requested, lastSeenTimestamp)
+			var samples []nvml.ProcessUtilizationSample
+			for _, sample := range buffer {
+				if sample.TimeStamp >= lastSeenTimestamp {
+					samples = append(samples, sample)
+				}
+			}
+			if len(samples) == 0 {
+				return nil, nvml.ERROR_NOT_FOUND
+			}
+			return samples, nvml.SUCCESS
+		},
+	}
+
+	poller := nvml.NewProcessUtilizationPoller(device)
+
+	buffer = []nvml.ProcessUtilizationSample{
+		{Pid: 100, TimeStamp: 1000, SmUtil: 10},
+		{Pid: 101, TimeStamp: 1100, SmUtil: 20},
+	}
+	samples, ret := poller.Poll()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, buffer, samples)
+
+	buffer = append(buffer, nvml.ProcessUtilizationSample{Pid: 100, TimeStamp: 1200, SmUtil: 30})
+	samples, ret = poller.Poll()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []nvml.ProcessUtilizationSample{{Pid: 100, TimeStamp: 1200, SmUtil: 30}}, samples)
+	require.Equal(t, []uint64{0, 1100}, requested)
+
+	samples, ret = poller.Poll()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Empty(t, samples)
+
+	// Simulate a driver restart that resets the timestamps. The driver
+	// filters by the last seen timestamp, so the first query after the
+	// restart finds nothing and the poller has to look at the whole buffer.
+	requested = nil
+	buffer = []nvml.ProcessUtilizationSample{{Pid: 200, TimeStamp: 10, SmUtil: 5}}
+	samples, ret = poller.Poll()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, buffer, samples)
+	require.Equal(t, []uint64{1200, 0}, requested)
+
+	buffer = append(buffer, nvml.ProcessUtilizationSample{Pid: 200, TimeStamp: 20, SmUtil: 15})
+	samples, ret = poller.Poll()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []nvml.ProcessUtilizationSample{{Pid: 200, TimeStamp: 20, SmUtil: 15}}, samples)
+	require.Equal(t, []uint64{1200, 0, 10}, requested)
+
+	device.GetProcessUtilizationFunc = func(lastSeenTimestamp uint64) ([]nvml.ProcessUtilizationSample, nvml.Return) {
+		require.Equal(t, uint64(20), lastSeenTimestamp)
+		return nil, nvml.ERROR_GPU_IS_LOST
+	}
+	_, ret = poller.Poll()
+	require.Equal(t, nvml.ERROR_GPU_IS_LOST, ret)
+}
+
+func TestProcessUtilizationPollerIdle(t *testing.T) {
+	buffer := []nvml.ProcessUtilizationSample{{Pid: 100, TimeStamp: 1000, SmUtil: 10}}
+	var requested []uint64
+	device := &mock.Device{
+		GetProcessUtilizationFunc: func(lastSeenTimestamp uint64) ([]nvml.ProcessUtilizationSample, nvml.Return) {
+			requested = append(requested, lastSeenTimestamp)
+			var samples []nvml.ProcessUtilizationSample
+			for _, sample := range buffer {
+				if sample.TimeStamp > lastSeenTimestamp {
+					samples = append(samples, sample)
+				}
+			}
+			if len(samples) == 0 {
+				return nil, nvml.ERROR_NOT_FOUND
+			}
+			return samples, nvml.SUCCESS
+		},
+	}
+
+	poller := nvml.NewProcessUtilizationPoller(device)
+
+	samples, ret := poller.Poll()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, buffer, samples)
+
+	// An idle device holds no samples newer than the last seen timestamp,
+	// but its buffer still reaches it, so this is not a restart.
+	samples, ret = poller.Poll()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Empty(t, samples)
+	require.Equal(t, []uint64{0, 1000, 0}, requested)
+
+	samples, ret = poller.Poll()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Empty(t, samples)
+}
@@ -0,0 +1,179 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"sort"
+	"time"
+)
+
+// PowerEfficiency reports the power draw of a device relative to its enforced
+// power limit, together with the fraction of a sampling window that the device
+// spent throttled by its power limit. Each value is only valid if the
+// corresponding Available field is set.
+type PowerEfficiency struct {
+	// PowerUsage is the power draw at the end of the window in milliwatts.
+	PowerUsage          uint32
+	PowerUsageAvailable bool
+	// EnforcedPowerLimit is the enforced power limit in milliwatts.
+	EnforcedPowerLimit          uint32
+	EnforcedPowerLimitAvailable bool
+	// PowerThrottledFraction is the fraction of the window, between 0 and 1,
+	// that the device spent power throttled.
+	PowerThrottledFraction          float64
+	PowerThrottledFractionAvailable bool
+}
+
+// PowerEfficiencySnapshot samples the power violation status of a device across
+// the specified window and combines this with its power usage and enforced
+// power limit. Sub-queries that are not supported leave their values marked as
+// unavailable; any other error is returned.
+func PowerEfficiencySnapshot(device Device, window time.Duration) (PowerEfficiency, Return) {
+	var snapshot PowerEfficiency
+
+	start, ret := device.GetViolationStatus(PERF_POLICY_POWER)
+	violationAvailable := ret == SUCCESS
+	if !violationAvailable && !isUnsupported(ret) {
+		return snapshot, ret
+	}
+
+	time.Sleep(window)
+
+	if violationAvailable {
+		end, ret := device.GetViolationStatus(PERF_POLICY_POWER)
+		if ret != SUCCESS && !isUnsupported(ret) {
+			return snapshot, ret
+		}
+		if ret == SUCCESS && end.ReferenceTime > start.ReferenceTime {
+			// The reference time is in microseconds and the violation time
+			// is in nanoseconds.
+			elapsed := float64(end.ReferenceTime-start.ReferenceTime) * 1000
+			throttled := float64(end.ViolationTime - start.ViolationTime)
+			snapshot.PowerThrottledFraction = throttled / elapsed
+			if snapshot.PowerThrottledFraction > 1 {
+				snapshot.PowerThrottledFraction = 1
+			}
+			snapshot.PowerThrottledFractionAvailable = true
+		}
+	}
+
+	powerUsage, ret := device.GetPowerUsage()
+	switch {
+	case ret == SUCCESS:
+		snapshot.PowerUsage = powerUsage
+		snapshot.PowerUsageAvailable = true
+	case !isUnsupported(ret):
+		return snapshot, ret
+	}
+
+	enforcedPowerLimit, ret := device.GetEnforcedPowerLimit()
+	switch {
+	case ret == SUCCESS:
+		snapshot.EnforcedPowerLimit = enforcedPowerLimit
+		snapshot.EnforcedPowerLimitAvailable = true
+	case !isUnsupported(ret):
+		return snapshot, ret
+	}
+
+	return snapshot, SUCCESS
+}
+
+// EnergySource identifies how an EnergyEstimate was obtained.
+type EnergySource string
+
+const (
+	// EnergySourceCounter indicates that the estimate is the difference of
+	// two GetTotalEnergyConsumption readings.
+	EnergySourceCounter EnergySource = "total-energy-consumption"
+	// EnergySourceSamples indicates that the estimate was integrated from
+	// TOTAL_POWER_SAMPLES samples.
+	EnergySourceSamples EnergySource = "power-samples"
+)
+
+// EnergyEstimate is the energy consumed by a device over a window.
+type EnergyEstimate struct {
+	Joules float64
+	Source EnergySource
+}
+
+// EstimateEnergy measures the energy consumed by a device over the specified
+// window, blocking until the window has passed. It prefers the energy counter
+// read by GetTotalEnergyConsumption. On devices without that counter, the
+// TOTAL_POWER_SAMPLES samples taken during the window are integrated using the
+// trapezoidal rule instead. ERROR_NOT_FOUND is returned if fewer than two
+// samples were taken during the window.
+func EstimateEnergy(device Device, window time.Duration) (EnergyEstimate, Return) {
+	start, ret := device.GetTotalEnergyConsumption()
+	if ret == SUCCESS {
+		time.Sleep(window)
+		end, ret := device.GetTotalEnergyConsumption()
+		if ret != SUCCESS {
+			return EnergyEstimate{}, ret
+		}
+		// The counter is in millijoules.
+		return EnergyEstimate{
+			Joules: float64(end-start) / 1000,
+			Source: EnergySourceCounter,
+		}, SUCCESS
+	}
+	if !isUnsupported(ret) {
+		return EnergyEstimate{}, ret
+	}
+
+	since := uint64(time.Now().UnixMicro())
+	time.Sleep(window)
+
+	valueType, samples, ret := device.GetSamples(TOTAL_POWER_SAMPLES, since)
+	if ret != SUCCESS {
+		return EnergyEstimate{}, ret
+	}
+	joules, ret := integratePowerSamples(valueType, samples)
+	if ret != SUCCESS {
+		return EnergyEstimate{}, ret
+	}
+	return EnergyEstimate{Joules: joules, Source: EnergySourceSamples}, SUCCESS
+}
+
+// integratePowerSamples integrates power samples in milliwatts over their
+// timestamps in microseconds using the trapezoidal rule, returning joules.
+func integratePowerSamples(valueType ValueType, samples []Sample) (float64, Return) {
+	if len(samples) < 2 {
+		return 0, ERROR_NOT_FOUND
+	}
+
+	sorted := make([]Sample, len(samples))
+	copy(sorted, samples)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].TimeStamp < sorted[j].TimeStamp
+	})
+
+	var milliwattMicroseconds float64
+	previous, ok := valueAsFloat64(valueType, sorted[0].SampleValue)
+	if !ok {
+		return 0, ERROR_UNKNOWN
+	}
+	for i := 1; i < len(sorted); i++ {
+		current, ok := valueAsFloat64(valueType, sorted[i].SampleValue)
+		if !ok {
+			return 0, ERROR_UNKNOWN
+		}
+		elapsed := float64(sorted[i].TimeStamp - sorted[i-1].TimeStamp)
+		milliwattMicroseconds += (previous + current) / 2 * elapsed
+		previous = current
+	}
+	return milliwattMicroseconds / 1e9, SUCCESS
+}
@@ -0,0 +1,198 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestPowerEfficiencySnapshot(t *testing.T) {
+	testCases := []struct {
+		description string
+		device      *mock.Device
+		expected    nvml.PowerEfficiency
+		expectedRet nvml.Return
+	}{
+		{
+			description: "all values available",
+			device: &mock.Device{
+				GetViolationStatusFunc: newViolationStatusFunc(
+					nvml.ViolationTime{ReferenceTime: 1000, ViolationTime: 0},
+					// 250ms throttled in a 1s window.
+					nvml.ViolationTime{ReferenceTime: 1001000, ViolationTime: 250000000},
+				),
+				GetPowerUsageFunc: func() (uint32, nvml.Return) {
+					return 300000, nvml.SUCCESS
+				},
+				GetEnforcedPowerLimitFunc: func() (uint32, nvml.Return) {
+					return 400000, nvml.SUCCESS
+				},
+			},
+			expected: nvml.PowerEfficiency{
+				PowerUsage:                      300000,
+				PowerUsageAvailable:             true,
+				EnforcedPowerLimit:              400000,
+				EnforcedPowerLimitAvailable:     true,
+				PowerThrottledFraction:          0.25,
+				PowerThrottledFractionAvailable: true,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "unsupported values are unavailable",
+			device: &mock.Device{
+				GetViolationStatusFunc: func(nvml.PerfPolicyType) (nvml.ViolationTime, nvml.Return) {
+					return nvml.ViolationTime{}, nvml.ERROR_NOT_SUPPORTED
+				},
+				GetPowerUsageFunc: func() (uint32, nvml.Return) {
+					return 300000, nvml.SUCCESS
+				},
+				GetEnforcedPowerLimitFunc: func() (uint32, nvml.Return) {
+					return 0, nvml.ERROR_NOT_SUPPORTED
+				},
+			},
+			expected: nvml.PowerEfficiency{
+				PowerUsage:          300000,
+				PowerUsageAvailable: true,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "other errors are returned",
+			device: &mock.Device{
+				GetViolationStatusFunc: func(nvml.PerfPolicyType) (nvml.ViolationTime, nvml.Return) {
+					return nvml.ViolationTime{}, nvml.ERROR_GPU_IS_LOST
+				},
+			},
+			expectedRet: nvml.ERROR_GPU_IS_LOST,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			snapshot, ret := nvml.PowerEfficiencySnapshot(tc.device, 0)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expected, snapshot)
+		})
+	}
+}
+
+// newViolationStatusFunc returns a GetViolationStatus mock that returns the
+// specified violation times on successive calls.
+func newViolationStatusFunc(times ...nvml.ViolationTime) func(nvml.PerfPolicyType) (nvml.ViolationTime, nvml.Return) {
+	return func(policy nvml.PerfPolicyType) (nvml.ViolationTime, nvml.Return) {
+		if policy != nvml.PERF_POLICY_POWER || len(times) == 0 {
+			return nvml.ViolationTime{}, nvml.ERROR_INVALID_ARGUMENT
+		}
+		t := times[0]
+		times = times[1:]
+		return t, nvml.SUCCESS
+	}
+}
+
+func TestEstimateEnergy(t *testing.T) {
+	powerSample := func(timestamp uint64, milliwatts uint32) nvml.Sample {
+		sample := nvml.Sample{TimeStamp: timestamp}
+		binary.LittleEndian.PutUint32(sample.SampleValue[:], milliwatts)
+		return sample
+	}
+
+	testCases := []struct {
+		description    string
+		energy         []uint64
+		energyRet      nvml.Return
+		samples        []nvml.Sample
+		samplesRet     nvml.Return
+		expectedEnergy nvml.EnergyEstimate
+		expectedRet    nvml.Return
+	}{
+		{
+			description: "energy counter",
+			energy:      []uint64{1000000, 1250500},
+			expectedEnergy: nvml.EnergyEstimate{
+				Joules: 250.5,
+				Source: nvml.EnergySourceCounter,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "power samples",
+			energyRet:   nvml.ERROR_NOT_SUPPORTED,
+			// 100 W to 200 W over the first second, then 200 W for two
+			// seconds, for 150 J + 400 J. Samples are not necessarily
+			// returned in order.
+			samples: []nvml.Sample{
+				powerSample(1000000, 200000),
+				powerSample(0, 100000),
+				powerSample(3000000, 200000),
+			},
+			expectedEnergy: nvml.EnergyEstimate{
+				Joules: 550,
+				Source: nvml.EnergySourceSamples,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "single power sample",
+			energyRet:   nvml.ERROR_NOT_SUPPORTED,
+			samples:     []nvml.Sample{powerSample(0, 100000)},
+			expectedRet: nvml.ERROR_NOT_FOUND,
+		},
+		{
+			description: "power samples not supported",
+			energyRet:   nvml.ERROR_NOT_SUPPORTED,
+			samplesRet:  nvml.ERROR_NOT_SUPPORTED,
+			expectedRet: nvml.ERROR_NOT_SUPPORTED,
+		},
+		{
+			description: "energy counter fails",
+			energyRet:   nvml.ERROR_UNKNOWN,
+			expectedRet: nvml.ERROR_UNKNOWN,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			device := &mock.Device{
+				GetTotalEnergyConsumptionFunc: func() (uint64, nvml.Return) {
+					if tc.energyRet != nvml.SUCCESS {
+						return 0, tc.energyRet
+					}
+					energy := tc.energy[0]
+					tc.energy = tc.energy[1:]
+					return energy, nvml.SUCCESS
+				},
+				GetSamplesFunc: func(samplingType nvml.SamplingType, lastSeenTimestamp uint64) (nvml.ValueType, []nvml.Sample, nvml.Return) {
+					require.Equal(t, nvml.TOTAL_POWER_SAMPLES, samplingType)
+					return nvml.VALUE_TYPE_UNSIGNED_INT, tc.samples, tc.samplesRet
+				},
+			}
+
+			energy, ret := nvml.EstimateEnergy(device, time.Millisecond)
+			require.Equal(t, tc.expectedRet, ret)
+			require.InDelta(t, tc.expectedEnergy.Joules, energy.Joules, 1e-9)
+			require.Equal(t, tc.expectedEnergy.Source, energy.Source)
+		})
+	}
+}
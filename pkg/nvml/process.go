@@ -0,0 +1,142 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"sort"
+)
+
+// valueNotAvailableUint64 is VALUE_NOT_AVAILABLE as reported in unsigned
+// long long fields, e.g. when the driver cannot attribute memory to a process.
+const valueNotAvailableUint64 = ^uint64(0)
+
+// MemoryUsed returns the GPU memory used by the process in bytes. The returned
+// bool is false if NVML reported the usage as not available, in which case
+// UsedGpuMemory holds a sentinel rather than a real amount.
+func (p ProcessInfo) MemoryUsed() (uint64, bool) {
+	if p.UsedGpuMemory == valueNotAvailableUint64 {
+		return 0, false
+	}
+	return p.UsedGpuMemory, true
+}
+
+// MemoryUsed returns the GPU memory used by the process in bytes. The returned
+// bool is false if NVML reported the usage as not available.
+func (p ProcessDetail_v1) MemoryUsed() (uint64, bool) {
+	if p.UsedGpuMemory == valueNotAvailableUint64 {
+		return 0, false
+	}
+	return p.UsedGpuMemory, true
+}
+
+// TopProcess is a utilization sample of a process along with its name.
+type TopProcess struct {
+	ProcessUtilizationSample
+	// Name is empty if the name of the process could not be resolved, e.g.
+	// because it has exited.
+	Name string
+}
+
+// TopProcesses returns up to n processes on a device with the highest SM
+// utilization since the given timestamp, sorted in descending order. If a
+// process has several samples in the window, only its latest one is
+// considered. Process names are resolved with SystemGetProcessName. If n is
+// not positive, all processes are returned.
+func TopProcesses(lib Interface, device Device, n int, since uint64) ([]TopProcess, Return) {
+	samples, ret := device.GetProcessUtilization(since)
+	if ret == ERROR_NOT_FOUND {
+		return []TopProcess{}, SUCCESS
+	}
+	if ret != SUCCESS {
+		return nil, ret
+	}
+
+	latest := latestProcessUtilization(samples)
+	processes := make([]TopProcess, 0, len(latest))
+	for _, sample := range latest {
+		processes = append(processes, TopProcess{ProcessUtilizationSample: sample})
+	}
+	sort.Slice(processes, func(i, j int) bool {
+		if processes[i].SmUtil != processes[j].SmUtil {
+			return processes[i].SmUtil > processes[j].SmUtil
+		}
+		return processes[i].Pid < processes[j].Pid
+	})
+	if n > 0 && len(processes) > n {
+		processes = processes[:n]
+	}
+
+	for i := range processes {
+		name, ret := lib.SystemGetProcessName(int(processes[i].Pid))
+		if ret == SUCCESS {
+			processes[i].Name = name
+		}
+	}
+	return processes, SUCCESS
+}
+
+// latestProcessUtilization returns the most recent of the utilization
+// samples of each process, keyed by pid. GetProcessUtilization may return
+// several samples for a process that was active across the sampling window.
+func latestProcessUtilization(samples []ProcessUtilizationSample) map[uint32]ProcessUtilizationSample {
+	latest := make(map[uint32]ProcessUtilizationSample)
+	for _, sample := range samples {
+		if previous, ok := latest[sample.Pid]; !ok || sample.TimeStamp > previous.TimeStamp {
+			latest[sample.Pid] = sample
+		}
+	}
+	return latest
+}
+
+// invalidInstanceId is the GPU or compute instance id reported for processes
+// that do not run in a MIG instance.
+const invalidInstanceId = ^uint32(0)
+
+// PhysicalGpuInstance is the key under which ProcessesByMigInstance lists
+// processes that do not run in a GPU instance.
+const PhysicalGpuInstance = -1
+
+// ProcessesByMigInstance returns the compute processes running on a device,
+// keyed by the id of the GPU instance they run in. The GPU instances are taken
+// from GetMigLayout, so every GPU instance of the device has an entry, which
+// is empty if no processes run in it. Processes that do not run in a GPU
+// instance, e.g. because MIG is disabled, are listed under
+// PhysicalGpuInstance.
+func ProcessesByMigInstance(device Device) (map[int][]ProcessInfo, Return) {
+	layout, ret := GetMigLayout(device)
+	if ret != SUCCESS {
+		return nil, ret
+	}
+
+	processes, ret := device.GetComputeRunningProcesses()
+	if ret != SUCCESS {
+		return nil, ret
+	}
+
+	byInstance := make(map[int][]ProcessInfo, len(layout))
+	for _, gpuInstance := range layout {
+		byInstance[int(gpuInstance.Info.Id)] = []ProcessInfo{}
+	}
+	for _, process := range processes {
+		key := PhysicalGpuInstance
+		if process.GpuInstanceId != invalidInstanceId {
+			key = int(process.GpuInstanceId)
+		}
+		byInstance[key] = append(byInstance[key], process)
+	}
+	return byInstance, SUCCESS
+}
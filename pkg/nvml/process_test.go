@@ -0,0 +1,189 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestProcessInfoMemoryUsed(t *testing.T) {
+	device := &mock.Device{
+		GetComputeRunningProcessesFunc: func() ([]nvml.ProcessInfo, nvml.Return) {
+			return []nvml.ProcessInfo{
+				{Pid: 100, UsedGpuMemory: 1 << 30},
+				{Pid: 101, UsedGpuMemory: ^uint64(0)},
+				{Pid: 102, UsedGpuMemory: 0},
+			}, nvml.SUCCESS
+		},
+	}
+
+	infos, ret := device.GetComputeRunningProcesses()
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	var total uint64
+	var unavailable []uint32
+	for _, info := range infos {
+		used, ok := info.MemoryUsed()
+		if !ok {
+			unavailable = append(unavailable, info.Pid)
+			continue
+		}
+		total += used
+	}
+	require.Equal(t, uint64(1<<30), total)
+	require.Equal(t, []uint32{101}, unavailable)
+
+	used, ok := nvml.ProcessDetail_v1{UsedGpuMemory: ^uint64(0)}.MemoryUsed()
+	require.False(t, ok)
+	require.Equal(t, uint64(0), used)
+}
+
+func TestTopProcesses(t *testing.T) {
+	names := map[int]string{100: "train.py", 101: "ffmpeg", 103: "jupyter"}
+	lib := &mock.Interface{
+		SystemGetProcessNameFunc: func(pid int) (string, nvml.Return) {
+			name, ok := names[pid]
+			if !ok {
+				return "", nvml.ERROR_NOT_FOUND
+			}
+			return name, nvml.SUCCESS
+		},
+	}
+	device := &mock.Device{
+		GetProcessUtilizationFunc: func(lastSeenTimestamp uint64) ([]nvml.ProcessUtilizationSample, nvml.Return) {
+			return []nvml.ProcessUtilizationSample{
+				{Pid: 100, TimeStamp: 1000, SmUtil: 20},
+				{Pid: 101, TimeStamp: 1000, SmUtil: 35},
+				{Pid: 102, TimeStamp: 1000, SmUtil: 50},
+				{Pid: 103, TimeStamp: 1000, SmUtil: 5},
+				{Pid: 100, TimeStamp: 1100, SmUtil: 80},
+			}, nvml.SUCCESS
+		},
+	}
+
+	top, ret := nvml.TopProcesses(lib, device, 3, 0)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []nvml.TopProcess{
+		{ProcessUtilizationSample: nvml.ProcessUtilizationSample{Pid: 100, TimeStamp: 1100, SmUtil: 80}, Name: "train.py"},
+		{ProcessUtilizationSample: nvml.ProcessUtilizationSample{Pid: 102, TimeStamp: 1000, SmUtil: 50}},
+		{ProcessUtilizationSample: nvml.ProcessUtilizationSample{Pid: 101, TimeStamp: 1000, SmUtil: 35}, Name: "ffmpeg"},
+	}, top)
+	require.Len(t, lib.SystemGetProcessNameCalls(), 3)
+
+	top, ret = nvml.TopProcesses(lib, device, 10, 0)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, top, 4)
+
+	device.GetProcessUtilizationFunc = func(lastSeenTimestamp uint64) ([]nvml.ProcessUtilizationSample, nvml.Return) {
+		return nil, nvml.ERROR_NOT_FOUND
+	}
+	top, ret = nvml.TopProcesses(lib, device, 3, 0)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Empty(t, top)
+}
+
+func TestProcessesByMigInstance(t *testing.T) {
+	const invalidId = ^uint32(0)
+
+	newGpuInstance := func(id uint32) nvml.GpuInstance {
+		return &mock.GpuInstance{
+			GetInfoFunc: func() (nvml.GpuInstanceInfo, nvml.Return) {
+				return nvml.GpuInstanceInfo{Id: id, ProfileId: nvml.GPU_INSTANCE_PROFILE_3_SLICE}, nvml.SUCCESS
+			},
+			GetComputeInstanceProfileInfoFunc: func(profile int, engProfile int) (nvml.ComputeInstanceProfileInfo, nvml.Return) {
+				return nvml.ComputeInstanceProfileInfo{}, nvml.ERROR_NOT_SUPPORTED
+			},
+		}
+	}
+
+	processes := []nvml.ProcessInfo{
+		{Pid: 10, UsedGpuMemory: 1 << 30, GpuInstanceId: 1, ComputeInstanceId: 0},
+		{Pid: 11, UsedGpuMemory: 2 << 30, GpuInstanceId: 1, ComputeInstanceId: 0},
+		{Pid: 12, UsedGpuMemory: 3 << 30, GpuInstanceId: invalidId, ComputeInstanceId: invalidId},
+	}
+
+	testCases := []struct {
+		description string
+		migMode     int
+		processes   []nvml.ProcessInfo
+		expected    map[int][]nvml.ProcessInfo
+	}{
+		{
+			description: "MIG enabled",
+			migMode:     nvml.DEVICE_MIG_ENABLE,
+			processes:   processes,
+			expected: map[int][]nvml.ProcessInfo{
+				1:                        {processes[0], processes[1]},
+				2:                        {},
+				nvml.PhysicalGpuInstance: {processes[2]},
+			},
+		},
+		{
+			description: "MIG disabled",
+			migMode:     nvml.DEVICE_MIG_DISABLE,
+			processes:   processes[2:],
+			expected: map[int][]nvml.ProcessInfo{
+				nvml.PhysicalGpuInstance: {processes[2]},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			device := &mock.Device{
+				GetMigModeFunc: func() (int, int, nvml.Return) {
+					return tc.migMode, tc.migMode, nvml.SUCCESS
+				},
+				GetGpuInstanceProfileInfoFunc: func(profile int) (nvml.GpuInstanceProfileInfo, nvml.Return) {
+					if profile != nvml.GPU_INSTANCE_PROFILE_3_SLICE {
+						return nvml.GpuInstanceProfileInfo{}, nvml.ERROR_NOT_SUPPORTED
+					}
+					return nvml.GpuInstanceProfileInfo{Id: uint32(profile)}, nvml.SUCCESS
+				},
+				GetGpuInstancesFunc: func(info *nvml.GpuInstanceProfileInfo) ([]nvml.GpuInstance, nvml.Return) {
+					return []nvml.GpuInstance{newGpuInstance(1), newGpuInstance(2)}, nvml.SUCCESS
+				},
+				GetComputeRunningProcessesFunc: func() ([]nvml.ProcessInfo, nvml.Return) {
+					return tc.processes, nvml.SUCCESS
+				},
+			}
+
+			byInstance, ret := nvml.ProcessesByMigInstance(device)
+			require.Equal(t, nvml.SUCCESS, ret)
+			require.Equal(t, tc.expected, byInstance)
+		})
+	}
+}
+
+func TestProcessesByMigInstanceError(t *testing.T) {
+	device := &mock.Device{
+		GetMigModeFunc: func() (int, int, nvml.Return) {
+			return nvml.DEVICE_MIG_DISABLE, nvml.DEVICE_MIG_DISABLE, nvml.SUCCESS
+		},
+		GetComputeRunningProcessesFunc: func() ([]nvml.ProcessInfo, nvml.Return) {
+			return nil, nvml.ERROR_NO_PERMISSION
+		},
+	}
+
+	_, ret := nvml.ProcessesByMigInstance(device)
+	require.Equal(t, nvml.ERROR_NO_PERMISSION, ret)
+}
@@ -0,0 +1,67 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"context"
+	"time"
+)
+
+// PstateHistogram samples the performance state of a device every interval
+// over the specified window and returns the time spent in each state. Each
+// successful sample accounts for one interval in the state it reported. Failed
+// samples are skipped, so the totals only cover the intervals whose state is
+// known; if every sample fails, the last error is returned.
+//
+// If ctx is done before the window has passed, the histogram of the samples
+// taken so far is returned along with ERROR_TIMEOUT.
+func PstateHistogram(ctx context.Context, device Device, window time.Duration, interval time.Duration) (map[Pstates]time.Duration, Return) {
+	histogram := make(map[Pstates]time.Duration)
+	if interval <= 0 {
+		return histogram, ERROR_INVALID_ARGUMENT
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	samples := int(window / interval)
+	lastRet := SUCCESS
+	succeeded := 0
+	for i := 0; i < samples; i++ {
+		pstate, ret := device.GetPerformanceState()
+		if ret == SUCCESS {
+			histogram[pstate] += interval
+			succeeded++
+		} else {
+			lastRet = ret
+		}
+
+		if i == samples-1 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return histogram, ERROR_TIMEOUT
+		case <-ticker.C:
+		}
+	}
+
+	if samples > 0 && succeeded == 0 {
+		return histogram, lastRet
+	}
+	return histogram, SUCCESS
+}
@@ -0,0 +1,112 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestPstateHistogram(t *testing.T) {
+	testCases := []struct {
+		description       string
+		pstates           []nvml.Pstates
+		rets              []nvml.Return
+		expectedHistogram map[nvml.Pstates]time.Duration
+		expectedRet       nvml.Return
+	}{
+		{
+			description: "changing pstates",
+			pstates: []nvml.Pstates{
+				nvml.PSTATE_8, nvml.PSTATE_0, nvml.PSTATE_0, nvml.PSTATE_2, nvml.PSTATE_0,
+			},
+			rets: []nvml.Return{
+				nvml.SUCCESS, nvml.SUCCESS, nvml.SUCCESS, nvml.SUCCESS, nvml.SUCCESS,
+			},
+			expectedHistogram: map[nvml.Pstates]time.Duration{
+				nvml.PSTATE_0: 3 * time.Millisecond,
+				nvml.PSTATE_2: time.Millisecond,
+				nvml.PSTATE_8: time.Millisecond,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "failed samples are skipped",
+			pstates: []nvml.Pstates{
+				nvml.PSTATE_0, nvml.PSTATE_0, nvml.PSTATE_2, nvml.PSTATE_0, nvml.PSTATE_0,
+			},
+			rets: []nvml.Return{
+				nvml.SUCCESS, nvml.ERROR_UNKNOWN, nvml.SUCCESS, nvml.ERROR_UNKNOWN, nvml.SUCCESS,
+			},
+			expectedHistogram: map[nvml.Pstates]time.Duration{
+				nvml.PSTATE_0: 2 * time.Millisecond,
+				nvml.PSTATE_2: time.Millisecond,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "all samples fail",
+			pstates: []nvml.Pstates{
+				nvml.PSTATE_UNKNOWN, nvml.PSTATE_UNKNOWN, nvml.PSTATE_UNKNOWN, nvml.PSTATE_UNKNOWN, nvml.PSTATE_UNKNOWN,
+			},
+			rets: []nvml.Return{
+				nvml.ERROR_NOT_SUPPORTED, nvml.ERROR_NOT_SUPPORTED, nvml.ERROR_NOT_SUPPORTED, nvml.ERROR_NOT_SUPPORTED, nvml.ERROR_NOT_SUPPORTED,
+			},
+			expectedHistogram: map[nvml.Pstates]time.Duration{},
+			expectedRet:       nvml.ERROR_NOT_SUPPORTED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			calls := 0
+			device := &mock.Device{
+				GetPerformanceStateFunc: func() (nvml.Pstates, nvml.Return) {
+					pstate, ret := tc.pstates[calls], tc.rets[calls]
+					calls++
+					return pstate, ret
+				},
+			}
+
+			histogram, ret := nvml.PstateHistogram(context.Background(), device, 5*time.Millisecond, time.Millisecond)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expectedHistogram, histogram)
+			require.Equal(t, len(tc.pstates), calls)
+		})
+	}
+}
+
+func TestPstateHistogramCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	device := &mock.Device{
+		GetPerformanceStateFunc: func() (nvml.Pstates, nvml.Return) {
+			cancel()
+			return nvml.PSTATE_0, nvml.SUCCESS
+		},
+	}
+
+	histogram, ret := nvml.PstateHistogram(ctx, device, time.Hour, time.Minute)
+	require.Equal(t, nvml.ERROR_TIMEOUT, ret)
+	require.Equal(t, map[nvml.Pstates]time.Duration{nvml.PSTATE_0: time.Minute}, histogram)
+	require.Len(t, device.GetPerformanceStateCalls(), 1)
+}
@@ -33,6 +33,24 @@ func (r Return) Error() string {
 	return errorStringFunc(r)
 }
 
+// isUnsupported returns true if the specified Return indicates that an API is
+// not supported by the device or not present in the loaded driver.
+func isUnsupported(r Return) bool {
+	return r == ERROR_NOT_SUPPORTED || r == ERROR_FUNCTION_NOT_FOUND
+}
+
+// available maps the Return of a query to whether its value is available.
+// Unsupported queries are not treated as an error.
+func available(r Return) (bool, Return) {
+	if r == SUCCESS {
+		return true, SUCCESS
+	}
+	if isUnsupported(r) {
+		return false, SUCCESS
+	}
+	return false, r
+}
+
 // Assigned to nvml.ErrorString if the system nvml library is in use.
 var errorStringFunc = defaultErrorStringFunc
 
@@ -0,0 +1,101 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+// AllSamples fetches the samples of every SamplingType from a device in a
+// single pass. The since map holds the last seen timestamp for each sampling
+// type and may be nil to fetch all samples; it is not modified. The updated
+// timestamps, holding the timestamp of the latest sample returned for each
+// type, are returned as a new map that can be passed to the next call to
+// fetch only new samples. Sampling types that are not supported by the
+// device, or that have no samples newer than their last seen timestamp, are
+// omitted from the result.
+func AllSamples(device Device, since map[SamplingType]uint64) (map[SamplingType][]Sample, map[SamplingType]uint64, Return) {
+	samples := make(map[SamplingType][]Sample)
+	latest := make(map[SamplingType]uint64, len(since))
+	for samplingType, timestamp := range since {
+		latest[samplingType] = timestamp
+	}
+
+	for samplingType := TOTAL_POWER_SAMPLES; samplingType < SAMPLINGTYPE_COUNT; samplingType++ {
+		_, s, ret := device.GetSamples(samplingType, since[samplingType])
+		if isUnsupported(ret) || ret == ERROR_NOT_FOUND {
+			continue
+		}
+		if ret != SUCCESS {
+			return nil, nil, ret
+		}
+		if len(s) == 0 {
+			continue
+		}
+		samples[samplingType] = s
+		for _, sample := range s {
+			if sample.TimeStamp > latest[samplingType] {
+				latest[samplingType] = sample.TimeStamp
+			}
+		}
+	}
+	return samples, latest, SUCCESS
+}
+
+// SampleStats summarizes the decoded values of a set of samples.
+type SampleStats struct {
+	Count           int
+	Min             float64
+	Max             float64
+	Mean            float64
+	LatestTimestamp uint64
+}
+
+// GetSampleStats fetches the samples of the specified type that are newer than
+// since and summarizes their values. A window without samples returns a zero
+// SampleStats and SUCCESS.
+func GetSampleStats(device Device, samplingType SamplingType, since uint64) (SampleStats, Return) {
+	var stats SampleStats
+
+	valueType, samples, ret := device.GetSamples(samplingType, since)
+	if ret == ERROR_NOT_FOUND {
+		return stats, SUCCESS
+	}
+	if ret != SUCCESS {
+		return stats, ret
+	}
+
+	var sum float64
+	for i, sample := range samples {
+		value, ok := valueAsFloat64(valueType, sample.SampleValue)
+		if !ok {
+			return SampleStats{}, ERROR_UNKNOWN
+		}
+		if i == 0 || value < stats.Min {
+			stats.Min = value
+		}
+		if i == 0 || value > stats.Max {
+			stats.Max = value
+		}
+		if sample.TimeStamp > stats.LatestTimestamp {
+			stats.LatestTimestamp = sample.TimeStamp
+		}
+		sum += value
+	}
+	stats.Count = len(samples)
+	if stats.Count > 0 {
+		stats.Mean = sum / float64(stats.Count)
+	}
+
+	return stats, SUCCESS
+}
@@ -0,0 +1,194 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestAllSamples(t *testing.T) {
+	available := map[nvml.SamplingType][]nvml.Sample{
+		nvml.TOTAL_POWER_SAMPLES: {
+			{TimeStamp: 100, SampleValue: [8]byte{1}},
+			{TimeStamp: 200, SampleValue: [8]byte{2}},
+		},
+		nvml.GPU_UTILIZATION_SAMPLES: {
+			{TimeStamp: 150, SampleValue: [8]byte{3}},
+		},
+		nvml.PROCESSOR_CLK_SAMPLES: {
+			{TimeStamp: 300, SampleValue: [8]byte{4}},
+		},
+	}
+
+	device := &mock.Device{
+		GetSamplesFunc: func(samplingType nvml.SamplingType, lastSeenTimestamp uint64) (nvml.ValueType, []nvml.Sample, nvml.Return) {
+			switch samplingType {
+			case nvml.DEC_UTILIZATION_SAMPLES:
+				return 0, nil, nvml.ERROR_NOT_FOUND
+			case nvml.JPG_UTILIZATION_SAMPLES, nvml.OFA_UTILIZATION_SAMPLES:
+				return 0, nil, nvml.ERROR_FUNCTION_NOT_FOUND
+			}
+			s, exists := available[samplingType]
+			if !exists {
+				return 0, nil, nvml.ERROR_NOT_SUPPORTED
+			}
+			var newer []nvml.Sample
+			for _, sample := range s {
+				if sample.TimeStamp > lastSeenTimestamp {
+					newer = append(newer, sample)
+				}
+			}
+			return nvml.VALUE_TYPE_UNSIGNED_INT, newer, nvml.SUCCESS
+		},
+	}
+
+	since := map[nvml.SamplingType]uint64{
+		nvml.TOTAL_POWER_SAMPLES: 100,
+	}
+	samples, latest, ret := nvml.AllSamples(device, since)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, map[nvml.SamplingType][]nvml.Sample{
+		nvml.TOTAL_POWER_SAMPLES:     available[nvml.TOTAL_POWER_SAMPLES][1:],
+		nvml.GPU_UTILIZATION_SAMPLES: available[nvml.GPU_UTILIZATION_SAMPLES],
+		nvml.PROCESSOR_CLK_SAMPLES:   available[nvml.PROCESSOR_CLK_SAMPLES],
+	}, samples)
+	require.Equal(t, map[nvml.SamplingType]uint64{
+		nvml.TOTAL_POWER_SAMPLES:     200,
+		nvml.GPU_UTILIZATION_SAMPLES: 150,
+		nvml.PROCESSOR_CLK_SAMPLES:   300,
+	}, latest)
+	// The caller's map is left untouched.
+	require.Equal(t, map[nvml.SamplingType]uint64{
+		nvml.TOTAL_POWER_SAMPLES: 100,
+	}, since)
+
+	// A second call with the updated timestamps returns no new samples.
+	samples, next, ret := nvml.AllSamples(device, latest)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Empty(t, samples)
+	require.Equal(t, latest, next)
+
+	// A nil map fetches every sample.
+	samples, latest, ret = nvml.AllSamples(device, nil)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Len(t, samples, 3)
+	require.Equal(t, uint64(200), latest[nvml.TOTAL_POWER_SAMPLES])
+
+	device.GetSamplesFunc = func(nvml.SamplingType, uint64) (nvml.ValueType, []nvml.Sample, nvml.Return) {
+		return 0, nil, nvml.ERROR_GPU_IS_LOST
+	}
+	_, _, ret = nvml.AllSamples(device, since)
+	require.Equal(t, nvml.ERROR_GPU_IS_LOST, ret)
+}
+
+func TestGetSampleStats(t *testing.T) {
+	uintSample := func(timestamp uint64, value uint32) nvml.Sample {
+		sample := nvml.Sample{TimeStamp: timestamp}
+		binary.LittleEndian.PutUint32(sample.SampleValue[:], value)
+		return sample
+	}
+	doubleSample := func(timestamp uint64, value float64) nvml.Sample {
+		sample := nvml.Sample{TimeStamp: timestamp}
+		binary.LittleEndian.PutUint64(sample.SampleValue[:], math.Float64bits(value))
+		return sample
+	}
+
+	testCases := []struct {
+		description   string
+		valueType     nvml.ValueType
+		samples       []nvml.Sample
+		ret           nvml.Return
+		expectedStats nvml.SampleStats
+		expectedRet   nvml.Return
+	}{
+		{
+			description: "unsigned int samples",
+			valueType:   nvml.VALUE_TYPE_UNSIGNED_INT,
+			samples: []nvml.Sample{
+				uintSample(100, 250000),
+				uintSample(300, 150000),
+				uintSample(200, 200000),
+			},
+			ret: nvml.SUCCESS,
+			expectedStats: nvml.SampleStats{
+				Count:           3,
+				Min:             150000,
+				Max:             250000,
+				Mean:            200000,
+				LatestTimestamp: 300,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "double samples",
+			valueType:   nvml.VALUE_TYPE_DOUBLE,
+			samples: []nvml.Sample{
+				doubleSample(100, 1.5),
+				doubleSample(200, 2.5),
+			},
+			ret: nvml.SUCCESS,
+			expectedStats: nvml.SampleStats{
+				Count:           2,
+				Min:             1.5,
+				Max:             2.5,
+				Mean:            2,
+				LatestTimestamp: 200,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "empty window",
+			valueType:   nvml.VALUE_TYPE_UNSIGNED_INT,
+			samples:     []nvml.Sample{},
+			ret:         nvml.SUCCESS,
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "no samples found",
+			ret:         nvml.ERROR_NOT_FOUND,
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "not supported",
+			ret:         nvml.ERROR_NOT_SUPPORTED,
+			expectedRet: nvml.ERROR_NOT_SUPPORTED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			device := &mock.Device{
+				GetSamplesFunc: func(samplingType nvml.SamplingType, lastSeenTimestamp uint64) (nvml.ValueType, []nvml.Sample, nvml.Return) {
+					require.Equal(t, nvml.TOTAL_POWER_SAMPLES, samplingType)
+					require.Equal(t, uint64(50), lastSeenTimestamp)
+					return tc.valueType, tc.samples, tc.ret
+				},
+			}
+
+			stats, ret := nvml.GetSampleStats(device, nvml.TOTAL_POWER_SAMPLES, 50)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expectedStats, stats)
+		})
+	}
+}
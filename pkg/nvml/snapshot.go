@@ -0,0 +1,208 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// DeviceSnapshot captures a point-in-time view of the state of a device for
+// use in change detection. Each value with a corresponding Available field is
+// only valid if that field is set.
+type DeviceSnapshot struct {
+	// Temperature is the GPU core temperature in degrees C.
+	Temperature          uint32
+	TemperatureAvailable bool
+	// PowerUsage is the power draw in milliwatts.
+	PowerUsage          uint32
+	PowerUsageAvailable bool
+	// GraphicsClock, SMClock and MemoryClock are the current clocks in MHz.
+	GraphicsClock          uint32
+	GraphicsClockAvailable bool
+	SMClock                uint32
+	SMClockAvailable       bool
+	MemoryClock            uint32
+	MemoryClockAvailable   bool
+	// PerformanceState is the current P-state.
+	PerformanceState          Pstates
+	PerformanceStateAvailable bool
+	// ThrottleReasons lists the names of the active clocks event reasons.
+	ThrottleReasons          []string
+	ThrottleReasonsAvailable bool
+	// SupportedThrottleReasons lists the names of the clocks event reasons
+	// that the device can report. It is empty if the device cannot report
+	// any, which distinguishes "not throttled" from "throttling not
+	// reportable" when ThrottleReasons is empty.
+	SupportedThrottleReasons []string
+	// CorrectedEccErrors and UncorrectedEccErrors are the volatile ECC error
+	// counts.
+	CorrectedEccErrors            uint64
+	CorrectedEccErrorsAvailable   bool
+	UncorrectedEccErrors          uint64
+	UncorrectedEccErrorsAvailable bool
+}
+
+// Snapshot captures a DeviceSnapshot of the specified device. Queries that are
+// not supported by the device leave their values marked as unavailable; any
+// other error is returned.
+func Snapshot(device Device) (DeviceSnapshot, Return) {
+	var snapshot DeviceSnapshot
+	var ret Return
+
+	snapshot.Temperature, ret = device.GetTemperature(TEMPERATURE_GPU)
+	if snapshot.TemperatureAvailable, ret = available(ret); ret != SUCCESS {
+		return snapshot, ret
+	}
+
+	snapshot.PowerUsage, ret = device.GetPowerUsage()
+	if snapshot.PowerUsageAvailable, ret = available(ret); ret != SUCCESS {
+		return snapshot, ret
+	}
+
+	snapshot.GraphicsClock, ret = device.GetClockInfo(CLOCK_GRAPHICS)
+	if snapshot.GraphicsClockAvailable, ret = available(ret); ret != SUCCESS {
+		return snapshot, ret
+	}
+
+	snapshot.SMClock, ret = device.GetClockInfo(CLOCK_SM)
+	if snapshot.SMClockAvailable, ret = available(ret); ret != SUCCESS {
+		return snapshot, ret
+	}
+
+	snapshot.MemoryClock, ret = device.GetClockInfo(CLOCK_MEM)
+	if snapshot.MemoryClockAvailable, ret = available(ret); ret != SUCCESS {
+		return snapshot, ret
+	}
+
+	snapshot.PerformanceState, ret = device.GetPerformanceState()
+	if snapshot.PerformanceStateAvailable, ret = available(ret); ret != SUCCESS {
+		return snapshot, ret
+	}
+
+	reasons, ret := device.GetCurrentClocksEventReasons()
+	if snapshot.ThrottleReasonsAvailable, ret = available(ret); ret != SUCCESS {
+		return snapshot, ret
+	}
+	if snapshot.ThrottleReasonsAvailable {
+		snapshot.ThrottleReasons = clocksEventReasonNames(reasons)
+	}
+
+	supportedReasons, ret := device.GetSupportedClocksEventReasons()
+	switch {
+	case ret == SUCCESS:
+		snapshot.SupportedThrottleReasons = clocksEventReasonNames(supportedReasons)
+	case isUnsupported(ret):
+		snapshot.SupportedThrottleReasons = []string{}
+	default:
+		return snapshot, ret
+	}
+
+	snapshot.CorrectedEccErrors, ret = device.GetTotalEccErrors(MEMORY_ERROR_TYPE_CORRECTED, VOLATILE_ECC)
+	if snapshot.CorrectedEccErrorsAvailable, ret = available(ret); ret != SUCCESS {
+		return snapshot, ret
+	}
+
+	snapshot.UncorrectedEccErrors, ret = device.GetTotalEccErrors(MEMORY_ERROR_TYPE_UNCORRECTED, VOLATILE_ECC)
+	if snapshot.UncorrectedEccErrorsAvailable, ret = available(ret); ret != SUCCESS {
+		return snapshot, ret
+	}
+
+	return snapshot, SUCCESS
+}
+
+// clocksEventReasons maps each clocks event reason bit to its name.
+var clocksEventReasons = []struct {
+	reason uint64
+	name   string
+}{
+	{ClocksEventReasonGpuIdle, "GpuIdle"},
+	{ClocksEventReasonApplicationsClocksSetting, "ApplicationsClocksSetting"},
+	{ClocksEventReasonSwPowerCap, "SwPowerCap"},
+	{ClocksThrottleReasonHwSlowdown, "HwSlowdown"},
+	{ClocksEventReasonSyncBoost, "SyncBoost"},
+	{ClocksEventReasonSwThermalSlowdown, "SwThermalSlowdown"},
+	{ClocksThrottleReasonHwThermalSlowdown, "HwThermalSlowdown"},
+	{ClocksThrottleReasonHwPowerBrakeSlowdown, "HwPowerBrakeSlowdown"},
+	{ClocksEventReasonDisplayClockSetting, "DisplayClockSetting"},
+}
+
+// clocksEventReasonNames returns the names of the clocks event reasons set in
+// the specified bitmask. Unknown bits are reported in hexadecimal.
+func clocksEventReasonNames(reasons uint64) []string {
+	names := []string{}
+	for _, r := range clocksEventReasons {
+		if reasons&r.reason != 0 {
+			names = append(names, r.name)
+			reasons &^= r.reason
+		}
+	}
+	if reasons != 0 {
+		names = append(names, fmt.Sprintf("0x%x", reasons))
+	}
+	return names
+}
+
+// SnapshotChange describes a field that differs between two DeviceSnapshots.
+// Old or New is nil if the field was unavailable in the earlier or later
+// snapshot respectively.
+type SnapshotChange struct {
+	Field string
+	Old   interface{}
+	New   interface{}
+}
+
+// DiffSnapshots returns the fields that differ between two DeviceSnapshots, in
+// the order in which they are declared. Fields that are unavailable in both
+// snapshots are ignored.
+func DiffSnapshots(before, after DeviceSnapshot) []SnapshotChange {
+	var changes []SnapshotChange
+
+	oldValue := reflect.ValueOf(before)
+	newValue := reflect.ValueOf(after)
+	snapshotType := oldValue.Type()
+	for i := 0; i < snapshotType.NumField(); i++ {
+		field := snapshotType.Field(i)
+		if strings.HasSuffix(field.Name, "Available") {
+			continue
+		}
+
+		oldAvailable, newAvailable := true, true
+		if available, ok := snapshotType.FieldByName(field.Name + "Available"); ok {
+			oldAvailable = oldValue.FieldByIndex(available.Index).Bool()
+			newAvailable = newValue.FieldByIndex(available.Index).Bool()
+		}
+		if !oldAvailable && !newAvailable {
+			continue
+		}
+
+		change := SnapshotChange{Field: field.Name}
+		if oldAvailable {
+			change.Old = oldValue.Field(i).Interface()
+		}
+		if newAvailable {
+			change.New = newValue.Field(i).Interface()
+		}
+		if oldAvailable && newAvailable && reflect.DeepEqual(change.Old, change.New) {
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	return changes
+}
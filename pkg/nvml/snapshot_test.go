@@ -0,0 +1,128 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestSnapshot(t *testing.T) {
+	device := &mock.Device{
+		GetTemperatureFunc: func(nvml.TemperatureSensors) (uint32, nvml.Return) {
+			return 65, nvml.SUCCESS
+		},
+		GetPowerUsageFunc: func() (uint32, nvml.Return) {
+			return 0, nvml.ERROR_NOT_SUPPORTED
+		},
+		GetClockInfoFunc: func(clockType nvml.ClockType) (uint32, nvml.Return) {
+			switch clockType {
+			case nvml.CLOCK_GRAPHICS:
+				return 1410, nvml.SUCCESS
+			case nvml.CLOCK_SM:
+				return 1405, nvml.SUCCESS
+			case nvml.CLOCK_MEM:
+				return 1215, nvml.SUCCESS
+			}
+			return 0, nvml.ERROR_INVALID_ARGUMENT
+		},
+		GetPerformanceStateFunc: func() (nvml.Pstates, nvml.Return) {
+			return nvml.PSTATE_0, nvml.SUCCESS
+		},
+		GetCurrentClocksEventReasonsFunc: func() (uint64, nvml.Return) {
+			return nvml.ClocksEventReasonSwPowerCap | nvml.ClocksThrottleReasonHwSlowdown | 0x1000, nvml.SUCCESS
+		},
+		GetSupportedClocksEventReasonsFunc: func() (uint64, nvml.Return) {
+			return nvml.ClocksEventReasonGpuIdle | nvml.ClocksEventReasonSwPowerCap | nvml.ClocksThrottleReasonHwSlowdown, nvml.SUCCESS
+		},
+		GetTotalEccErrorsFunc: func(nvml.MemoryErrorType, nvml.EccCounterType) (uint64, nvml.Return) {
+			return 0, nvml.ERROR_FUNCTION_NOT_FOUND
+		},
+	}
+
+	snapshot, ret := nvml.Snapshot(device)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.DeviceSnapshot{
+		Temperature:               65,
+		TemperatureAvailable:      true,
+		GraphicsClock:             1410,
+		GraphicsClockAvailable:    true,
+		SMClock:                   1405,
+		SMClockAvailable:          true,
+		MemoryClock:               1215,
+		MemoryClockAvailable:      true,
+		PerformanceState:          nvml.PSTATE_0,
+		PerformanceStateAvailable: true,
+		ThrottleReasons:           []string{"SwPowerCap", "HwSlowdown", "0x1000"},
+		ThrottleReasonsAvailable:  true,
+		SupportedThrottleReasons:  []string{"GpuIdle", "SwPowerCap", "HwSlowdown"},
+	}, snapshot)
+
+	device.GetSupportedClocksEventReasonsFunc = func() (uint64, nvml.Return) {
+		return 0, nvml.ERROR_NOT_SUPPORTED
+	}
+	snapshot, ret = nvml.Snapshot(device)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, []string{}, snapshot.SupportedThrottleReasons)
+
+	device.GetTemperatureFunc = func(nvml.TemperatureSensors) (uint32, nvml.Return) {
+		return 0, nvml.ERROR_GPU_IS_LOST
+	}
+	_, ret = nvml.Snapshot(device)
+	require.Equal(t, nvml.ERROR_GPU_IS_LOST, ret)
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	before := nvml.DeviceSnapshot{
+		Temperature:                   60,
+		TemperatureAvailable:          true,
+		GraphicsClock:                 1410,
+		GraphicsClockAvailable:        true,
+		SMClock:                       1410,
+		SMClockAvailable:              true,
+		ThrottleReasons:               []string{"GpuIdle"},
+		ThrottleReasonsAvailable:      true,
+		UncorrectedEccErrors:          0,
+		UncorrectedEccErrorsAvailable: true,
+	}
+	after := nvml.DeviceSnapshot{
+		Temperature:                   60,
+		TemperatureAvailable:          true,
+		PowerUsage:                    250000,
+		PowerUsageAvailable:           true,
+		GraphicsClock:                 1200,
+		GraphicsClockAvailable:        true,
+		ThrottleReasons:               []string{"GpuIdle", "SwThermalSlowdown"},
+		ThrottleReasonsAvailable:      true,
+		UncorrectedEccErrors:          2,
+		UncorrectedEccErrorsAvailable: true,
+	}
+
+	require.Equal(t, []nvml.SnapshotChange{
+		{Field: "PowerUsage", Old: nil, New: uint32(250000)},
+		{Field: "GraphicsClock", Old: uint32(1410), New: uint32(1200)},
+		{Field: "SMClock", Old: uint32(1410), New: nil},
+		{Field: "ThrottleReasons", Old: []string{"GpuIdle"}, New: []string{"GpuIdle", "SwThermalSlowdown"}},
+		{Field: "UncorrectedEccErrors", Old: uint64(0), New: uint64(2)},
+	}, nvml.DiffSnapshots(before, after))
+
+	require.Empty(t, nvml.DiffSnapshots(after, after))
+}
@@ -0,0 +1,506 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"fmt"
+	"strings"
+)
+
+// String returns the string representation of a NvLinkCapability.
+func (c NvLinkCapability) String() string {
+	switch c {
+	case NVLINK_CAP_P2P_SUPPORTED:
+		return "NVLINK_CAP_P2P_SUPPORTED"
+	case NVLINK_CAP_SYSMEM_ACCESS:
+		return "NVLINK_CAP_SYSMEM_ACCESS"
+	case NVLINK_CAP_P2P_ATOMICS:
+		return "NVLINK_CAP_P2P_ATOMICS"
+	case NVLINK_CAP_SYSMEM_ATOMICS:
+		return "NVLINK_CAP_SYSMEM_ATOMICS"
+	case NVLINK_CAP_SLI_BRIDGE:
+		return "NVLINK_CAP_SLI_BRIDGE"
+	case NVLINK_CAP_VALID:
+		return "NVLINK_CAP_VALID"
+	default:
+		return fmt.Sprintf("unknown NvLinkCapability value: %d", c)
+	}
+}
+
+// String returns the string representation of a NvLinkErrorCounter.
+func (c NvLinkErrorCounter) String() string {
+	switch c {
+	case NVLINK_ERROR_DL_REPLAY:
+		return "NVLINK_ERROR_DL_REPLAY"
+	case NVLINK_ERROR_DL_RECOVERY:
+		return "NVLINK_ERROR_DL_RECOVERY"
+	case NVLINK_ERROR_DL_CRC_FLIT:
+		return "NVLINK_ERROR_DL_CRC_FLIT"
+	case NVLINK_ERROR_DL_CRC_DATA:
+		return "NVLINK_ERROR_DL_CRC_DATA"
+	case NVLINK_ERROR_DL_ECC_DATA:
+		return "NVLINK_ERROR_DL_ECC_DATA"
+	default:
+		return fmt.Sprintf("unknown NvLinkErrorCounter value: %d", c)
+	}
+}
+
+// String returns the string representation of a HostVgpuMode.
+func (m HostVgpuMode) String() string {
+	switch m {
+	case HOST_VGPU_MODE_NON_SRIOV:
+		return "HOST_VGPU_MODE_NON_SRIOV"
+	case HOST_VGPU_MODE_SRIOV:
+		return "HOST_VGPU_MODE_SRIOV"
+	default:
+		return fmt.Sprintf("unknown HostVgpuMode value: %d", m)
+	}
+}
+
+// String returns the string representation of an EnableState.
+func (s EnableState) String() string {
+	switch s {
+	case FEATURE_DISABLED:
+		return "FEATURE_DISABLED"
+	case FEATURE_ENABLED:
+		return "FEATURE_ENABLED"
+	default:
+		return fmt.Sprintf("unknown EnableState value: %d", s)
+	}
+}
+
+// String returns the string representation of an EccCounterType.
+func (t EccCounterType) String() string {
+	switch t {
+	case VOLATILE_ECC:
+		return "VOLATILE_ECC"
+	case AGGREGATE_ECC:
+		return "AGGREGATE_ECC"
+	default:
+		return fmt.Sprintf("unknown EccCounterType value: %d", t)
+	}
+}
+
+// String returns the string representation of a MemoryErrorType.
+func (t MemoryErrorType) String() string {
+	switch t {
+	case MEMORY_ERROR_TYPE_CORRECTED:
+		return "MEMORY_ERROR_TYPE_CORRECTED"
+	case MEMORY_ERROR_TYPE_UNCORRECTED:
+		return "MEMORY_ERROR_TYPE_UNCORRECTED"
+	default:
+		return fmt.Sprintf("unknown MemoryErrorType value: %d", t)
+	}
+}
+
+// String returns the string representation of a Pstates. PSTATE_0 is the
+// maximum performance state and PSTATE_15 the minimum.
+func (p Pstates) String() string {
+	switch p {
+	case PSTATE_0:
+		return "PSTATE_0"
+	case PSTATE_1:
+		return "PSTATE_1"
+	case PSTATE_2:
+		return "PSTATE_2"
+	case PSTATE_3:
+		return "PSTATE_3"
+	case PSTATE_4:
+		return "PSTATE_4"
+	case PSTATE_5:
+		return "PSTATE_5"
+	case PSTATE_6:
+		return "PSTATE_6"
+	case PSTATE_7:
+		return "PSTATE_7"
+	case PSTATE_8:
+		return "PSTATE_8"
+	case PSTATE_9:
+		return "PSTATE_9"
+	case PSTATE_10:
+		return "PSTATE_10"
+	case PSTATE_11:
+		return "PSTATE_11"
+	case PSTATE_12:
+		return "PSTATE_12"
+	case PSTATE_13:
+		return "PSTATE_13"
+	case PSTATE_14:
+		return "PSTATE_14"
+	case PSTATE_15:
+		return "PSTATE_15"
+	case PSTATE_UNKNOWN:
+		return "PSTATE_UNKNOWN"
+	default:
+		return fmt.Sprintf("unknown Pstates value: %d", p)
+	}
+}
+
+// String returns the string representation of a UtilizationSource.
+func (s UtilizationSource) String() string {
+	switch s {
+	case UTILIZATION_SOURCE_DEVICE:
+		return "UTILIZATION_SOURCE_DEVICE"
+	case UTILIZATION_SOURCE_GPM:
+		return "UTILIZATION_SOURCE_GPM"
+	case UTILIZATION_SOURCE_PROCESS:
+		return "UTILIZATION_SOURCE_PROCESS"
+	default:
+		return fmt.Sprintf("unknown UtilizationSource value: %d", s)
+	}
+}
+
+// String returns the string representation of a MemoryLocation.
+// MEMORY_LOCATION_DRAM is an alias of MEMORY_LOCATION_DEVICE_MEMORY and is
+// reported as the latter.
+func (l MemoryLocation) String() string {
+	switch l {
+	case MEMORY_LOCATION_L1_CACHE:
+		return "MEMORY_LOCATION_L1_CACHE"
+	case MEMORY_LOCATION_L2_CACHE:
+		return "MEMORY_LOCATION_L2_CACHE"
+	case MEMORY_LOCATION_DEVICE_MEMORY:
+		return "MEMORY_LOCATION_DEVICE_MEMORY"
+	case MEMORY_LOCATION_REGISTER_FILE:
+		return "MEMORY_LOCATION_REGISTER_FILE"
+	case MEMORY_LOCATION_TEXTURE_MEMORY:
+		return "MEMORY_LOCATION_TEXTURE_MEMORY"
+	case MEMORY_LOCATION_TEXTURE_SHM:
+		return "MEMORY_LOCATION_TEXTURE_SHM"
+	case MEMORY_LOCATION_CBU:
+		return "MEMORY_LOCATION_CBU"
+	case MEMORY_LOCATION_SRAM:
+		return "MEMORY_LOCATION_SRAM"
+	default:
+		return fmt.Sprintf("unknown MemoryLocation value: %d", l)
+	}
+}
+
+// String returns the string representation of a VgpuVmCompatibility. As the
+// values are bit flags, a combination is returned as the names of the set
+// flags joined by "|".
+func (c VgpuVmCompatibility) String() string {
+	if c == VGPU_VM_COMPATIBILITY_NONE {
+		return "VGPU_VM_COMPATIBILITY_NONE"
+	}
+	return flagsString(c, "VgpuVmCompatibility", []VgpuVmCompatibility{
+		VGPU_VM_COMPATIBILITY_COLD,
+		VGPU_VM_COMPATIBILITY_HIBERNATE,
+		VGPU_VM_COMPATIBILITY_SLEEP,
+		VGPU_VM_COMPATIBILITY_LIVE,
+	}, []string{
+		"VGPU_VM_COMPATIBILITY_COLD",
+		"VGPU_VM_COMPATIBILITY_HIBERNATE",
+		"VGPU_VM_COMPATIBILITY_SLEEP",
+		"VGPU_VM_COMPATIBILITY_LIVE",
+	})
+}
+
+// String returns the string representation of a
+// VgpuPgpuCompatibilityLimitCode. As the values are bit flags, a combination
+// is returned as the names of the set flags joined by "|".
+func (c VgpuPgpuCompatibilityLimitCode) String() string {
+	if c == VGPU_COMPATIBILITY_LIMIT_NONE {
+		return "VGPU_COMPATIBILITY_LIMIT_NONE"
+	}
+	return flagsString(c, "VgpuPgpuCompatibilityLimitCode", []VgpuPgpuCompatibilityLimitCode{
+		VGPU_COMPATIBILITY_LIMIT_HOST_DRIVER,
+		VGPU_COMPATIBILITY_LIMIT_GUEST_DRIVER,
+		VGPU_COMPATIBILITY_LIMIT_GPU,
+		VGPU_COMPATIBILITY_LIMIT_OTHER,
+	}, []string{
+		"VGPU_COMPATIBILITY_LIMIT_HOST_DRIVER",
+		"VGPU_COMPATIBILITY_LIMIT_GUEST_DRIVER",
+		"VGPU_COMPATIBILITY_LIMIT_GPU",
+		"VGPU_COMPATIBILITY_LIMIT_OTHER",
+	})
+}
+
+// String returns the string representation of a NvLinkUtilizationCountUnits.
+func (u NvLinkUtilizationCountUnits) String() string {
+	switch u {
+	case NVLINK_COUNTER_UNIT_CYCLES:
+		return "NVLINK_COUNTER_UNIT_CYCLES"
+	case NVLINK_COUNTER_UNIT_PACKETS:
+		return "NVLINK_COUNTER_UNIT_PACKETS"
+	case NVLINK_COUNTER_UNIT_BYTES:
+		return "NVLINK_COUNTER_UNIT_BYTES"
+	case NVLINK_COUNTER_UNIT_RESERVED:
+		return "NVLINK_COUNTER_UNIT_RESERVED"
+	default:
+		return fmt.Sprintf("unknown NvLinkUtilizationCountUnits value: %d", u)
+	}
+}
+
+// String returns the string representation of a NvLinkUtilizationCountPktTypes.
+// Since the packet types form a bitmask, combined filters are joined with "|".
+func (p NvLinkUtilizationCountPktTypes) String() string {
+	if p == NVLINK_COUNTER_PKTFILTER_ALL {
+		return "NVLINK_COUNTER_PKTFILTER_ALL"
+	}
+	return flagsString(p, "NvLinkUtilizationCountPktTypes", []NvLinkUtilizationCountPktTypes{
+		NVLINK_COUNTER_PKTFILTER_NOP,
+		NVLINK_COUNTER_PKTFILTER_READ,
+		NVLINK_COUNTER_PKTFILTER_WRITE,
+		NVLINK_COUNTER_PKTFILTER_RATOM,
+		NVLINK_COUNTER_PKTFILTER_NRATOM,
+		NVLINK_COUNTER_PKTFILTER_FLUSH,
+		NVLINK_COUNTER_PKTFILTER_RESPDATA,
+		NVLINK_COUNTER_PKTFILTER_RESPNODATA,
+	}, []string{
+		"NVLINK_COUNTER_PKTFILTER_NOP",
+		"NVLINK_COUNTER_PKTFILTER_READ",
+		"NVLINK_COUNTER_PKTFILTER_WRITE",
+		"NVLINK_COUNTER_PKTFILTER_RATOM",
+		"NVLINK_COUNTER_PKTFILTER_NRATOM",
+		"NVLINK_COUNTER_PKTFILTER_FLUSH",
+		"NVLINK_COUNTER_PKTFILTER_RESPDATA",
+		"NVLINK_COUNTER_PKTFILTER_RESPNODATA",
+	})
+}
+
+// String returns the string representation of a ClockType.
+func (c ClockType) String() string {
+	switch c {
+	case CLOCK_GRAPHICS:
+		return "CLOCK_GRAPHICS"
+	case CLOCK_SM:
+		return "CLOCK_SM"
+	case CLOCK_MEM:
+		return "CLOCK_MEM"
+	case CLOCK_VIDEO:
+		return "CLOCK_VIDEO"
+	default:
+		return fmt.Sprintf("unknown ClockType value: %d", c)
+	}
+}
+
+// String returns the string representation of an IntNvLinkDeviceType.
+func (t IntNvLinkDeviceType) String() string {
+	switch t {
+	case NVLINK_DEVICE_TYPE_GPU:
+		return "NVLINK_DEVICE_TYPE_GPU"
+	case NVLINK_DEVICE_TYPE_IBMNPU:
+		return "NVLINK_DEVICE_TYPE_IBMNPU"
+	case NVLINK_DEVICE_TYPE_SWITCH:
+		return "NVLINK_DEVICE_TYPE_SWITCH"
+	case NVLINK_DEVICE_TYPE_UNKNOWN:
+		return "NVLINK_DEVICE_TYPE_UNKNOWN"
+	default:
+		return fmt.Sprintf("unknown IntNvLinkDeviceType value: %d", t)
+	}
+}
+
+// String returns the string representation of a ThermalController.
+func (c ThermalController) String() string {
+	switch c {
+	case THERMAL_CONTROLLER_NONE:
+		return "THERMAL_CONTROLLER_NONE"
+	case THERMAL_CONTROLLER_GPU_INTERNAL:
+		return "THERMAL_CONTROLLER_GPU_INTERNAL"
+	case THERMAL_CONTROLLER_ADM1032:
+		return "THERMAL_CONTROLLER_ADM1032"
+	case THERMAL_CONTROLLER_ADT7461:
+		return "THERMAL_CONTROLLER_ADT7461"
+	case THERMAL_CONTROLLER_MAX6649:
+		return "THERMAL_CONTROLLER_MAX6649"
+	case THERMAL_CONTROLLER_MAX1617:
+		return "THERMAL_CONTROLLER_MAX1617"
+	case THERMAL_CONTROLLER_LM99:
+		return "THERMAL_CONTROLLER_LM99"
+	case THERMAL_CONTROLLER_LM89:
+		return "THERMAL_CONTROLLER_LM89"
+	case THERMAL_CONTROLLER_LM64:
+		return "THERMAL_CONTROLLER_LM64"
+	case THERMAL_CONTROLLER_G781:
+		return "THERMAL_CONTROLLER_G781"
+	case THERMAL_CONTROLLER_ADT7473:
+		return "THERMAL_CONTROLLER_ADT7473"
+	case THERMAL_CONTROLLER_SBMAX6649:
+		return "THERMAL_CONTROLLER_SBMAX6649"
+	case THERMAL_CONTROLLER_VBIOSEVT:
+		return "THERMAL_CONTROLLER_VBIOSEVT"
+	case THERMAL_CONTROLLER_OS:
+		return "THERMAL_CONTROLLER_OS"
+	case THERMAL_CONTROLLER_NVSYSCON_CANOAS:
+		return "THERMAL_CONTROLLER_NVSYSCON_CANOAS"
+	case THERMAL_CONTROLLER_NVSYSCON_E551:
+		return "THERMAL_CONTROLLER_NVSYSCON_E551"
+	case THERMAL_CONTROLLER_MAX6649R:
+		return "THERMAL_CONTROLLER_MAX6649R"
+	case THERMAL_CONTROLLER_ADT7473S:
+		return "THERMAL_CONTROLLER_ADT7473S"
+	case THERMAL_CONTROLLER_UNKNOWN:
+		return "THERMAL_CONTROLLER_UNKNOWN"
+	default:
+		return fmt.Sprintf("unknown ThermalController value: %d", c)
+	}
+}
+
+// String returns the string representation of a ThermalTarget.
+func (t ThermalTarget) String() string {
+	switch t {
+	case THERMAL_TARGET_NONE:
+		return "THERMAL_TARGET_NONE"
+	case THERMAL_TARGET_GPU:
+		return "THERMAL_TARGET_GPU"
+	case THERMAL_TARGET_MEMORY:
+		return "THERMAL_TARGET_MEMORY"
+	case THERMAL_TARGET_POWER_SUPPLY:
+		return "THERMAL_TARGET_POWER_SUPPLY"
+	case THERMAL_TARGET_BOARD:
+		return "THERMAL_TARGET_BOARD"
+	case THERMAL_TARGET_VCD_BOARD:
+		return "THERMAL_TARGET_VCD_BOARD"
+	case THERMAL_TARGET_VCD_INLET:
+		return "THERMAL_TARGET_VCD_INLET"
+	case THERMAL_TARGET_VCD_OUTLET:
+		return "THERMAL_TARGET_VCD_OUTLET"
+	case THERMAL_TARGET_ALL:
+		return "THERMAL_TARGET_ALL"
+	case THERMAL_TARGET_UNKNOWN:
+		return "THERMAL_TARGET_UNKNOWN"
+	default:
+		return fmt.Sprintf("unknown ThermalTarget value: %d", t)
+	}
+}
+
+// String returns the string representation of a ConfComputeCpuCaps.
+func (c ConfComputeCpuCaps) String() string {
+	switch c {
+	case CC_SYSTEM_CPU_CAPS_NONE:
+		return "CC_SYSTEM_CPU_CAPS_NONE"
+	case CC_SYSTEM_CPU_CAPS_AMD_SEV:
+		return "CC_SYSTEM_CPU_CAPS_AMD_SEV"
+	case CC_SYSTEM_CPU_CAPS_INTEL_TDX:
+		return "CC_SYSTEM_CPU_CAPS_INTEL_TDX"
+	default:
+		return fmt.Sprintf("unknown ConfComputeCpuCaps value: %d", c)
+	}
+}
+
+// String returns the string representation of a ConfComputeGpusCaps.
+func (c ConfComputeGpusCaps) String() string {
+	switch c {
+	case CC_SYSTEM_GPUS_CC_NOT_CAPABLE:
+		return "CC_SYSTEM_GPUS_CC_NOT_CAPABLE"
+	case CC_SYSTEM_GPUS_CC_CAPABLE:
+		return "CC_SYSTEM_GPUS_CC_CAPABLE"
+	default:
+		return fmt.Sprintf("unknown ConfComputeGpusCaps value: %d", c)
+	}
+}
+
+// String returns the string representation of a GpuOperationMode.
+func (m GpuOperationMode) String() string {
+	switch m {
+	case GOM_ALL_ON:
+		return "GOM_ALL_ON"
+	case GOM_COMPUTE:
+		return "GOM_COMPUTE"
+	case GOM_LOW_DP:
+		return "GOM_LOW_DP"
+	default:
+		return fmt.Sprintf("unknown GpuOperationMode value: %d", m)
+	}
+}
+
+// String returns the string representation of a BusType.
+func (t BusType) String() string {
+	switch t {
+	case BUS_TYPE_UNKNOWN:
+		return "BUS_TYPE_UNKNOWN"
+	case BUS_TYPE_PCI:
+		return "BUS_TYPE_PCI"
+	case BUS_TYPE_PCIE:
+		return "BUS_TYPE_PCIE"
+	case BUS_TYPE_FPCI:
+		return "BUS_TYPE_FPCI"
+	case BUS_TYPE_AGP:
+		return "BUS_TYPE_AGP"
+	default:
+		return fmt.Sprintf("unknown BusType value: %d", t)
+	}
+}
+
+// String returns the string representation of a ClockId.
+func (c ClockId) String() string {
+	switch c {
+	case CLOCK_ID_CURRENT:
+		return "CLOCK_ID_CURRENT"
+	case CLOCK_ID_APP_CLOCK_TARGET:
+		return "CLOCK_ID_APP_CLOCK_TARGET"
+	case CLOCK_ID_APP_CLOCK_DEFAULT:
+		return "CLOCK_ID_APP_CLOCK_DEFAULT"
+	case CLOCK_ID_CUSTOMER_BOOST_MAX:
+		return "CLOCK_ID_CUSTOMER_BOOST_MAX"
+	default:
+		return fmt.Sprintf("unknown ClockId value: %d", c)
+	}
+}
+
+// String returns the string representation of a DriverModel.
+func (d DriverModel) String() string {
+	switch d {
+	case DRIVER_WDDM:
+		return "DRIVER_WDDM"
+	case DRIVER_WDM:
+		return "DRIVER_WDM"
+	default:
+		return fmt.Sprintf("unknown DriverModel value: %d", d)
+	}
+}
+
+// String returns the string representation of an EventType.
+func (e EventType) String() string {
+	switch e {
+	case EventTypeSingleBitEccError:
+		return "EventTypeSingleBitEccError"
+	case EventTypeDoubleBitEccError:
+		return "EventTypeDoubleBitEccError"
+	case EventTypePState:
+		return "EventTypePState"
+	case EventTypeXidCriticalError:
+		return "EventTypeXidCriticalError"
+	case EventTypeClock:
+		return "EventTypeClock"
+	case EventTypePowerSourceChange:
+		return "EventTypePowerSourceChange"
+	case EventMigConfigChange:
+		return "EventMigConfigChange"
+	default:
+		return fmt.Sprintf("unknown EventType value: %d", e)
+	}
+}
+
+// flagsString joins the names of the flags set in value with "|". If value
+// contains bits that are not among the flags, the value is reported as
+// unknown.
+func flagsString[T ~int32](value T, typeName string, flags []T, names []string) string {
+	var set []string
+	remaining := value
+	for i, flag := range flags {
+		if value&flag != 0 {
+			set = append(set, names[i])
+			remaining &^= flag
+		}
+	}
+	if remaining != 0 {
+		return fmt.Sprintf("unknown %s value: %d", typeName, value)
+	}
+	return strings.Join(set, "|")
+}
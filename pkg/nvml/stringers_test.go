@@ -0,0 +1,112 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringers(t *testing.T) {
+	testCases := []struct {
+		value    fmt.Stringer
+		expected string
+	}{
+		{NVLINK_CAP_P2P_SUPPORTED, "NVLINK_CAP_P2P_SUPPORTED"},
+		{NVLINK_CAP_VALID, "NVLINK_CAP_VALID"},
+		{NVLINK_CAP_COUNT, "unknown NvLinkCapability value: 6"},
+		{NVLINK_ERROR_DL_CRC_FLIT, "NVLINK_ERROR_DL_CRC_FLIT"},
+		{NVLINK_ERROR_DL_CRC_DATA, "NVLINK_ERROR_DL_CRC_DATA"},
+		{NVLINK_ERROR_COUNT, "unknown NvLinkErrorCounter value: 5"},
+		{HOST_VGPU_MODE_NON_SRIOV, "HOST_VGPU_MODE_NON_SRIOV"},
+		{HOST_VGPU_MODE_SRIOV, "HOST_VGPU_MODE_SRIOV"},
+		{HostVgpuMode(2), "unknown HostVgpuMode value: 2"},
+		{FEATURE_DISABLED, "FEATURE_DISABLED"},
+		{FEATURE_ENABLED, "FEATURE_ENABLED"},
+		{EnableState(2), "unknown EnableState value: 2"},
+		{VOLATILE_ECC, "VOLATILE_ECC"},
+		{AGGREGATE_ECC, "AGGREGATE_ECC"},
+		{ECC_COUNTER_TYPE_COUNT, "unknown EccCounterType value: 2"},
+		{MEMORY_ERROR_TYPE_CORRECTED, "MEMORY_ERROR_TYPE_CORRECTED"},
+		{MEMORY_ERROR_TYPE_UNCORRECTED, "MEMORY_ERROR_TYPE_UNCORRECTED"},
+		{MEMORY_ERROR_TYPE_COUNT, "unknown MemoryErrorType value: 2"},
+		{PSTATE_0, "PSTATE_0"},
+		{PSTATE_15, "PSTATE_15"},
+		{PSTATE_UNKNOWN, "PSTATE_UNKNOWN"},
+		{Pstates(16), "unknown Pstates value: 16"},
+		{UTILIZATION_SOURCE_DEVICE, "UTILIZATION_SOURCE_DEVICE"},
+		{UTILIZATION_SOURCE_PROCESS, "UTILIZATION_SOURCE_PROCESS"},
+		{UtilizationSource(3), "unknown UtilizationSource value: 3"},
+		{MEMORY_LOCATION_L1_CACHE, "MEMORY_LOCATION_L1_CACHE"},
+		{MEMORY_LOCATION_DRAM, "MEMORY_LOCATION_DEVICE_MEMORY"},
+		{MEMORY_LOCATION_SRAM, "MEMORY_LOCATION_SRAM"},
+		{MEMORY_LOCATION_COUNT, "unknown MemoryLocation value: 8"},
+		{VGPU_VM_COMPATIBILITY_NONE, "VGPU_VM_COMPATIBILITY_NONE"},
+		{VGPU_VM_COMPATIBILITY_LIVE, "VGPU_VM_COMPATIBILITY_LIVE"},
+		{VGPU_VM_COMPATIBILITY_COLD | VGPU_VM_COMPATIBILITY_SLEEP, "VGPU_VM_COMPATIBILITY_COLD|VGPU_VM_COMPATIBILITY_SLEEP"},
+		{VgpuVmCompatibility(16), "unknown VgpuVmCompatibility value: 16"},
+		{VGPU_COMPATIBILITY_LIMIT_NONE, "VGPU_COMPATIBILITY_LIMIT_NONE"},
+		{VGPU_COMPATIBILITY_LIMIT_GUEST_DRIVER, "VGPU_COMPATIBILITY_LIMIT_GUEST_DRIVER"},
+		{VGPU_COMPATIBILITY_LIMIT_HOST_DRIVER | VGPU_COMPATIBILITY_LIMIT_OTHER, "VGPU_COMPATIBILITY_LIMIT_HOST_DRIVER|VGPU_COMPATIBILITY_LIMIT_OTHER"},
+		{NVLINK_COUNTER_UNIT_BYTES, "NVLINK_COUNTER_UNIT_BYTES"},
+		{NVLINK_COUNTER_UNIT_COUNT, "unknown NvLinkUtilizationCountUnits value: 4"},
+		{NVLINK_COUNTER_PKTFILTER_ALL, "NVLINK_COUNTER_PKTFILTER_ALL"},
+		{NVLINK_COUNTER_PKTFILTER_READ | NVLINK_COUNTER_PKTFILTER_WRITE, "NVLINK_COUNTER_PKTFILTER_READ|NVLINK_COUNTER_PKTFILTER_WRITE"},
+		{NvLinkUtilizationCountPktTypes(256), "unknown NvLinkUtilizationCountPktTypes value: 256"},
+		{CLOCK_GRAPHICS, "CLOCK_GRAPHICS"},
+		{CLOCK_VIDEO, "CLOCK_VIDEO"},
+		{CLOCK_COUNT, "unknown ClockType value: 4"},
+		{NVLINK_DEVICE_TYPE_GPU, "NVLINK_DEVICE_TYPE_GPU"},
+		{NVLINK_DEVICE_TYPE_SWITCH, "NVLINK_DEVICE_TYPE_SWITCH"},
+		{NVLINK_DEVICE_TYPE_UNKNOWN, "NVLINK_DEVICE_TYPE_UNKNOWN"},
+		{IntNvLinkDeviceType(3), "unknown IntNvLinkDeviceType value: 3"},
+		{THERMAL_CONTROLLER_GPU_INTERNAL, "THERMAL_CONTROLLER_GPU_INTERNAL"},
+		{THERMAL_CONTROLLER_UNKNOWN, "THERMAL_CONTROLLER_UNKNOWN"},
+		{ThermalController(18), "unknown ThermalController value: 18"},
+		{THERMAL_TARGET_GPU, "THERMAL_TARGET_GPU"},
+		{THERMAL_TARGET_VCD_INLET, "THERMAL_TARGET_VCD_INLET"},
+		{THERMAL_TARGET_UNKNOWN, "THERMAL_TARGET_UNKNOWN"},
+		{ThermalTarget(3), "unknown ThermalTarget value: 3"},
+		{ConfComputeCpuCaps(CC_SYSTEM_CPU_CAPS_AMD_SEV), "CC_SYSTEM_CPU_CAPS_AMD_SEV"},
+		{ConfComputeCpuCaps(3), "unknown ConfComputeCpuCaps value: 3"},
+		{ConfComputeGpusCaps(CC_SYSTEM_GPUS_CC_CAPABLE), "CC_SYSTEM_GPUS_CC_CAPABLE"},
+		{ConfComputeGpusCaps(2), "unknown ConfComputeGpusCaps value: 2"},
+		{GOM_ALL_ON, "GOM_ALL_ON"},
+		{GOM_LOW_DP, "GOM_LOW_DP"},
+		{GpuOperationMode(3), "unknown GpuOperationMode value: 3"},
+		{BusType(BUS_TYPE_UNKNOWN), "BUS_TYPE_UNKNOWN"},
+		{BusType(BUS_TYPE_PCIE), "BUS_TYPE_PCIE"},
+		{BusType(5), "unknown BusType value: 5"},
+		{CLOCK_ID_CURRENT, "CLOCK_ID_CURRENT"},
+		{CLOCK_ID_CUSTOMER_BOOST_MAX, "CLOCK_ID_CUSTOMER_BOOST_MAX"},
+		{CLOCK_ID_COUNT, "unknown ClockId value: 4"},
+		{DRIVER_WDDM, "DRIVER_WDDM"},
+		{DRIVER_WDM, "DRIVER_WDM"},
+		{DriverModel(2), "unknown DriverModel value: 2"},
+		{EventType(EventTypeXidCriticalError), "EventTypeXidCriticalError"},
+		{EventType(EventMigConfigChange), "EventMigConfigChange"},
+		{EventType(EventTypeXidCriticalError | EventTypeClock), "unknown EventType value: 24"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expected, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.value.String())
+		})
+	}
+}
@@ -54,7 +54,7 @@ func (l *library) SystemGetHicVersion() ([]HwbcEntry, Return) {
 	var hwbcCount uint32 = 1 // Will be reduced upon returning
 	for {
 		hwbcEntries := make([]HwbcEntry, hwbcCount)
-		ret := nvmlSystemGetHicVersion(&hwbcCount, &hwbcEntries[0])
+		ret := nvmlSystemGetHicVersionStub(&hwbcCount, &hwbcEntries[0])
 		if ret == SUCCESS {
 			return hwbcEntries[:hwbcCount], ret
 		}
@@ -65,6 +65,9 @@ func (l *library) SystemGetHicVersion() ([]HwbcEntry, Return) {
 	}
 }
 
+// nvmlSystemGetHicVersionStub allows us to override this for testing.
+var nvmlSystemGetHicVersionStub = nvmlSystemGetHicVersion
+
 // nvml.SystemGetTopologyGpuSet()
 func (l *library) SystemGetTopologyGpuSet(cpuNumber int) ([]Device, Return) {
 	var count uint32
@@ -81,12 +84,19 @@ func (l *library) SystemGetTopologyGpuSet(cpuNumber int) ([]Device, Return) {
 }
 
 // nvml.SystemGetConfComputeCapabilities()
+//
+// Use CpuCapabilities and GpusCapabilities on the result to interpret its
+// fields. Systems without confidential compute support return
+// ERROR_NOT_SUPPORTED.
 func (l *library) SystemGetConfComputeCapabilities() (ConfComputeSystemCaps, Return) {
 	var capabilities ConfComputeSystemCaps
-	ret := nvmlSystemGetConfComputeCapabilities(&capabilities)
+	ret := nvmlSystemGetConfComputeCapabilitiesStub(&capabilities)
 	return capabilities, ret
 }
 
+// nvmlSystemGetConfComputeCapabilitiesStub allows us to override this for testing.
+var nvmlSystemGetConfComputeCapabilitiesStub = nvmlSystemGetConfComputeCapabilities
+
 // nvml.SystemGetConfComputeState()
 func SystemGetConfComputeState() (ConfComputeSystemState, Return) {
 	var state ConfComputeSystemState
@@ -107,17 +117,30 @@ func SystemSetConfComputeGpusReadyState(isAcceptingWork uint32) Return {
 }
 
 // nvml.SystemSetNvlinkBwMode()
-func SystemSetNvlinkBwMode(nvlinkBwMode uint32) Return {
-	return nvmlSystemSetNvlinkBwMode(nvlinkBwMode)
+//
+// SystemSetNvlinkBwMode sets the global NVLink bandwidth mode, e.g. to reduce
+// the bandwidth for power savings. It requires root and returns
+// ERROR_NO_PERMISSION otherwise, and ERROR_IN_USE if P2P objects exist.
+func (l *library) SystemSetNvlinkBwMode(nvlinkBwMode uint32) Return {
+	return nvmlSystemSetNvlinkBwModeStub(nvlinkBwMode)
 }
 
+// nvmlSystemSetNvlinkBwModeStub allows us to override this for testing.
+var nvmlSystemSetNvlinkBwModeStub = nvmlSystemSetNvlinkBwMode
+
 // nvml.SystemGetNvlinkBwMode()
-func SystemGetNvlinkBwMode() (uint32, Return) {
+//
+// SystemGetNvlinkBwMode returns the global NVLink bandwidth mode. It is only
+// supported on Hopper or newer GPUs.
+func (l *library) SystemGetNvlinkBwMode() (uint32, Return) {
 	var nvlinkBwMode uint32
-	ret := nvmlSystemGetNvlinkBwMode(&nvlinkBwMode)
+	ret := nvmlSystemGetNvlinkBwModeStub(&nvlinkBwMode)
 	return nvlinkBwMode, ret
 }
 
+// nvmlSystemGetNvlinkBwModeStub allows us to override this for testing.
+var nvmlSystemGetNvlinkBwModeStub = nvmlSystemGetNvlinkBwMode
+
 // nvml.SystemGetConfComputeKeyRotationThresholdInfo()
 func (l *library) SystemGetConfComputeKeyRotationThresholdInfo() (ConfComputeGetKeyRotationThresholdInfo, Return) {
 	var keyRotationThresholdInfo ConfComputeGetKeyRotationThresholdInfo
@@ -0,0 +1,144 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemGetHicVersion(t *testing.T) {
+	newEntry := func(id uint32, version string) HwbcEntry {
+		entry := HwbcEntry{HwbcId: id}
+		for i, c := range version {
+			entry.FirmwareVersion[i] = int8(c)
+		}
+		return entry
+	}
+
+	original := nvmlSystemGetHicVersionStub
+	defer func() {
+		nvmlSystemGetHicVersionStub = original
+	}()
+
+	testCases := []struct {
+		description     string
+		entries         []HwbcEntry
+		expectedEntries []HwbcEntry
+	}{
+		{
+			description:     "no HICs",
+			entries:         []HwbcEntry{},
+			expectedEntries: []HwbcEntry{},
+		},
+		{
+			description: "multiple HICs",
+			entries: []HwbcEntry{
+				newEntry(1, "1.0.0"),
+				newEntry(2, "1.0.1"),
+				newEntry(3, "2.0.0"),
+			},
+			expectedEntries: []HwbcEntry{
+				newEntry(1, "1.0.0"),
+				newEntry(2, "1.0.1"),
+				newEntry(3, "2.0.0"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			nvmlSystemGetHicVersionStub = func(hwbcCount *uint32, hwbcEntries *HwbcEntry) Return {
+				if int(*hwbcCount) < len(tc.entries) {
+					*hwbcCount = uint32(len(tc.entries))
+					return ERROR_INSUFFICIENT_SIZE
+				}
+				copy(unsafe.Slice(hwbcEntries, *hwbcCount), tc.entries)
+				*hwbcCount = uint32(len(tc.entries))
+				return SUCCESS
+			}
+
+			entries, ret := libnvml.SystemGetHicVersion()
+			require.Equal(t, SUCCESS, ret)
+			require.Equal(t, tc.expectedEntries, entries)
+		})
+	}
+}
+
+func TestSystemNvlinkBwMode(t *testing.T) {
+	originalGet := nvmlSystemGetNvlinkBwModeStub
+	originalSet := nvmlSystemSetNvlinkBwModeStub
+	defer func() {
+		nvmlSystemGetNvlinkBwModeStub = originalGet
+		nvmlSystemSetNvlinkBwModeStub = originalSet
+	}()
+
+	var mode uint32
+	root := true
+	nvmlSystemGetNvlinkBwModeStub = func(nvlinkBwMode *uint32) Return {
+		*nvlinkBwMode = mode
+		return SUCCESS
+	}
+	nvmlSystemSetNvlinkBwModeStub = func(nvlinkBwMode uint32) Return {
+		if !root {
+			return ERROR_NO_PERMISSION
+		}
+		mode = nvlinkBwMode
+		return SUCCESS
+	}
+
+	ret := libnvml.SystemSetNvlinkBwMode(2)
+	require.Equal(t, SUCCESS, ret)
+
+	got, ret := libnvml.SystemGetNvlinkBwMode()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, uint32(2), got)
+
+	root = false
+	ret = libnvml.SystemSetNvlinkBwMode(0)
+	require.Equal(t, ERROR_NO_PERMISSION, ret)
+
+	got, ret = libnvml.SystemGetNvlinkBwMode()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, uint32(2), got)
+}
+
+func TestSystemGetConfComputeCapabilities(t *testing.T) {
+	original := nvmlSystemGetConfComputeCapabilitiesStub
+	defer func() {
+		nvmlSystemGetConfComputeCapabilitiesStub = original
+	}()
+
+	nvmlSystemGetConfComputeCapabilitiesStub = func(capabilities *ConfComputeSystemCaps) Return {
+		capabilities.CpuCaps = CC_SYSTEM_CPU_CAPS_INTEL_TDX
+		capabilities.GpusCaps = CC_SYSTEM_GPUS_CC_CAPABLE
+		return SUCCESS
+	}
+	capabilities, ret := libnvml.SystemGetConfComputeCapabilities()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, ConfComputeCpuCaps(CC_SYSTEM_CPU_CAPS_INTEL_TDX), capabilities.CpuCapabilities())
+	require.Equal(t, "CC_SYSTEM_CPU_CAPS_INTEL_TDX", capabilities.CpuCapabilities().String())
+	require.Equal(t, "CC_SYSTEM_GPUS_CC_CAPABLE", capabilities.GpusCapabilities().String())
+
+	nvmlSystemGetConfComputeCapabilitiesStub = func(capabilities *ConfComputeSystemCaps) Return {
+		return ERROR_NOT_SUPPORTED
+	}
+	_, ret = libnvml.SystemGetConfComputeCapabilities()
+	require.Equal(t, ERROR_NOT_SUPPORTED, ret)
+}
@@ -0,0 +1,65 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+// Temperatures holds the readings of the temperature sensors of a device in
+// degrees C. Each value is only valid if the corresponding Available field is
+// set.
+type Temperatures struct {
+	Gpu             uint32
+	GpuAvailable    bool
+	Memory          uint32
+	MemoryAvailable bool
+}
+
+// GetTemperatures reads all temperature sensors of a device. The versioned
+// nvmlDeviceGetTemperatureV call is not available in the NVML headers these
+// bindings are generated from, so the GPU temperature is read with
+// GetTemperature and the memory temperature with the FI_DEV_MEMORY_TEMP field
+// value. Sensors that are not present leave their values marked as
+// unavailable; any other error is returned.
+func GetTemperatures(device Device) (Temperatures, Return) {
+	var temperatures Temperatures
+
+	gpu, ret := device.GetTemperature(TEMPERATURE_GPU)
+	switch {
+	case ret == SUCCESS:
+		temperatures.Gpu = gpu
+		temperatures.GpuAvailable = true
+	case !isUnsupported(ret):
+		return temperatures, ret
+	}
+
+	values := []FieldValue{{FieldId: FI_DEV_MEMORY_TEMP}}
+	ret = device.GetFieldValues(values)
+	if ret == SUCCESS {
+		ret = Return(values[0].NvmlReturn)
+	}
+	switch {
+	case ret == SUCCESS:
+		memory, ok := valueAsFloat64(ValueType(values[0].ValueType), values[0].Value)
+		if !ok {
+			return temperatures, ERROR_UNKNOWN
+		}
+		temperatures.Memory = uint32(memory)
+		temperatures.MemoryAvailable = true
+	case !isUnsupported(ret):
+		return temperatures, ret
+	}
+
+	return temperatures, SUCCESS
+}
@@ -0,0 +1,109 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestGetTemperatures(t *testing.T) {
+	memoryTemperature := func(ret nvml.Return, fieldRet nvml.Return, value uint32) func([]nvml.FieldValue) nvml.Return {
+		return func(values []nvml.FieldValue) nvml.Return {
+			require.Len(t, values, 1)
+			require.Equal(t, uint32(nvml.FI_DEV_MEMORY_TEMP), values[0].FieldId)
+			values[0].NvmlReturn = uint32(fieldRet)
+			values[0].ValueType = uint32(nvml.VALUE_TYPE_UNSIGNED_INT)
+			binary.LittleEndian.PutUint32(values[0].Value[:], value)
+			return ret
+		}
+	}
+
+	testCases := []struct {
+		description string
+		device      *mock.Device
+		expected    nvml.Temperatures
+		expectedRet nvml.Return
+	}{
+		{
+			description: "GPU and memory sensors",
+			device: &mock.Device{
+				GetTemperatureFunc: func(nvml.TemperatureSensors) (uint32, nvml.Return) {
+					return 55, nvml.SUCCESS
+				},
+				GetFieldValuesFunc: memoryTemperature(nvml.SUCCESS, nvml.SUCCESS, 70),
+			},
+			expected: nvml.Temperatures{
+				Gpu:             55,
+				GpuAvailable:    true,
+				Memory:          70,
+				MemoryAvailable: true,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "no memory sensor",
+			device: &mock.Device{
+				GetTemperatureFunc: func(nvml.TemperatureSensors) (uint32, nvml.Return) {
+					return 55, nvml.SUCCESS
+				},
+				GetFieldValuesFunc: memoryTemperature(nvml.SUCCESS, nvml.ERROR_NOT_SUPPORTED, 0),
+			},
+			expected: nvml.Temperatures{
+				Gpu:          55,
+				GpuAvailable: true,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "field values not found in driver",
+			device: &mock.Device{
+				GetTemperatureFunc: func(nvml.TemperatureSensors) (uint32, nvml.Return) {
+					return 55, nvml.SUCCESS
+				},
+				GetFieldValuesFunc: memoryTemperature(nvml.ERROR_FUNCTION_NOT_FOUND, nvml.SUCCESS, 0),
+			},
+			expected: nvml.Temperatures{
+				Gpu:          55,
+				GpuAvailable: true,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "GPU sensor error",
+			device: &mock.Device{
+				GetTemperatureFunc: func(nvml.TemperatureSensors) (uint32, nvml.Return) {
+					return 0, nvml.ERROR_GPU_IS_LOST
+				},
+			},
+			expectedRet: nvml.ERROR_GPU_IS_LOST,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			temperatures, ret := nvml.GetTemperatures(tc.device)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expected, temperatures)
+		})
+	}
+}
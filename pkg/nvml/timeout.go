@@ -0,0 +1,111 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"time"
+)
+
+// NewTimeoutInterface returns an Interface that calls the methods of lib in a
+// new goroutine each and returns ERROR_TIMEOUT for any call that does not
+// complete within timeout, as CallWithTimeout does. Handles returned by the
+// Interface, such as devices, GPU instances and event sets, are wrapped in the
+// same way, so calls made on them are also subject to the timeout. Methods
+// that do not return a Return, such as the versioned handler accessors, are
+// passed through unchanged.
+//
+// This is a safety valve rather than a cancellation: the NVML call that timed
+// out may still be running, and its results are discarded. Slices and structs
+// passed by pointer, such as the values passed to DeviceGetFieldValues, may
+// still be written to by such a call and must not be reused after
+// ERROR_TIMEOUT.
+func NewTimeoutInterface(lib Interface, timeout time.Duration) Interface {
+	return timeoutInterface{Interface: lib, timeout: timeout}
+}
+
+// timeoutInterface decorates an Interface with a timeout for each call. Its
+// methods are generated in zz_generated.timeout.go.
+//
+//go:generate go run ../../gen/timeout/generatetimeout.go -input zz_generated.api.go -output zz_generated.timeout.go
+type timeoutInterface struct {
+	Interface
+	timeout time.Duration
+}
+
+// timeoutHandle is implemented by the decorators of the handle types, such as
+// timeoutDevice, which are generated together with the methods of
+// timeoutInterface. Each decorator embeds the handle it wraps, so that
+// nvmlDeviceHandle can still find an underlying nvmlDevice.
+type timeoutHandle[T any] interface {
+	unwrapTimeout() T
+}
+
+// unwrapTimeout returns the handle decorated by a timeout decorator, or handle
+// itself if it is not decorated. Decorated methods unwrap their handle
+// arguments, since implementations such as RegisterEvents convert them to the
+// concrete nvml types.
+func unwrapTimeout[T any](handle T) T {
+	if t, ok := any(handle).(timeoutHandle[T]); ok {
+		return t.unwrapTimeout()
+	}
+	return handle
+}
+
+// WithTimeout calls f in a new goroutine and waits at most timeout for it to
+// complete, returning ERROR_TIMEOUT if it does not. This guards callers
+// against NVML calls that block for a long time, e.g. on hardware faults.
+//
+// Note that this is not a cancellation: the NVML call made by f may still be
+// running after WithTimeout has returned, and its results are discarded. f
+// must therefore not write to variables shared with the caller; use
+// CallWithTimeout to return a value instead.
+func WithTimeout(timeout time.Duration, f func() Return) Return {
+	_, ret := CallWithTimeout(timeout, func() (struct{}, Return) {
+		return struct{}{}, f()
+	})
+	return ret
+}
+
+// CallWithTimeout calls f in a new goroutine and waits at most timeout for it
+// to complete. If f completes in time, its results are returned; otherwise the
+// zero value and ERROR_TIMEOUT are returned. As with WithTimeout, the NVML
+// call made by f may still be running after a timeout.
+func CallWithTimeout[T any](timeout time.Duration, f func() (T, Return)) (T, Return) {
+	type result struct {
+		value T
+		ret   Return
+	}
+
+	// The channel is buffered so that the goroutine can complete and be
+	// garbage collected if the caller has already timed out.
+	done := make(chan result, 1)
+	go func() {
+		value, ret := f()
+		done <- result{value, ret}
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case r := <-done:
+		return r.value, r.ret
+	case <-timer.C:
+		var zero T
+		return zero, ERROR_TIMEOUT
+	}
+}
@@ -0,0 +1,200 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestCallWithTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	device := &mock.Device{
+		GetTemperatureFunc: func(nvml.TemperatureSensors) (uint32, nvml.Return) {
+			return 50, nvml.SUCCESS
+		},
+		GetPowerUsageFunc: func() (uint32, nvml.Return) {
+			// Simulate a call that blocks on a hardware fault.
+			<-release
+			return 100000, nvml.SUCCESS
+		},
+	}
+	lib := &mock.Interface{
+		DeviceGetTemperatureFunc: func(device nvml.Device, sensorType nvml.TemperatureSensors) (uint32, nvml.Return) {
+			return device.GetTemperature(sensorType)
+		},
+		DeviceGetPowerUsageFunc: func(device nvml.Device) (uint32, nvml.Return) {
+			return device.GetPowerUsage()
+		},
+	}
+
+	temperature, ret := nvml.CallWithTimeout(time.Second, func() (uint32, nvml.Return) {
+		return lib.DeviceGetTemperature(device, nvml.TEMPERATURE_GPU)
+	})
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(50), temperature)
+
+	power, ret := nvml.CallWithTimeout(10*time.Millisecond, func() (uint32, nvml.Return) {
+		return lib.DeviceGetPowerUsage(device)
+	})
+	require.Equal(t, nvml.ERROR_TIMEOUT, ret)
+	require.Equal(t, uint32(0), power)
+
+	ret = nvml.WithTimeout(10*time.Millisecond, func() nvml.Return {
+		_, ret := lib.DeviceGetPowerUsage(device)
+		return ret
+	})
+	require.Equal(t, nvml.ERROR_TIMEOUT, ret)
+
+	ret = nvml.WithTimeout(time.Second, func() nvml.Return {
+		return nvml.ERROR_NOT_SUPPORTED
+	})
+	require.Equal(t, nvml.ERROR_NOT_SUPPORTED, ret)
+}
+
+func TestTimeoutInterface(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	device := &mock.Device{
+		GetTemperatureFunc: func(nvml.TemperatureSensors) (uint32, nvml.Return) {
+			return 50, nvml.SUCCESS
+		},
+		GetPowerUsageFunc: func() (uint32, nvml.Return) {
+			// Simulate a call that blocks on a hardware fault.
+			<-release
+			return 100000, nvml.SUCCESS
+		},
+		GetClockInfoFunc: func(nvml.ClockType) (uint32, nvml.Return) {
+			<-release
+			return 1410, nvml.SUCCESS
+		},
+	}
+	lib := &mock.Interface{
+		InitFunc: func() nvml.Return {
+			return nvml.SUCCESS
+		},
+		SystemGetDriverVersionFunc: func() (string, nvml.Return) {
+			<-release
+			return "550.54.15", nvml.SUCCESS
+		},
+		DeviceGetHandleByIndexFunc: func(index int) (nvml.Device, nvml.Return) {
+			return device, nvml.SUCCESS
+		},
+		DeviceGetPowerUsageFunc: func(device nvml.Device) (uint32, nvml.Return) {
+			return device.GetPowerUsage()
+		},
+		DeviceGetMigModeFunc: func(device nvml.Device) (int, int, nvml.Return) {
+			return nvml.DEVICE_MIG_ENABLE, nvml.DEVICE_MIG_DISABLE, nvml.SUCCESS
+		},
+	}
+
+	timeoutLib := nvml.NewTimeoutInterface(lib, 10*time.Millisecond)
+
+	require.Equal(t, nvml.SUCCESS, timeoutLib.Init())
+
+	version, ret := timeoutLib.SystemGetDriverVersion()
+	require.Equal(t, nvml.ERROR_TIMEOUT, ret)
+	require.Empty(t, version)
+
+	d, ret := timeoutLib.DeviceGetHandleByIndex(0)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	current, pending, ret := timeoutLib.DeviceGetMigMode(d)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.DEVICE_MIG_ENABLE, current)
+	require.Equal(t, nvml.DEVICE_MIG_DISABLE, pending)
+
+	_, ret = timeoutLib.DeviceGetPowerUsage(d)
+	require.Equal(t, nvml.ERROR_TIMEOUT, ret)
+
+	// Calls made on the returned handle are subject to the timeout too.
+	temperature, ret := d.GetTemperature(nvml.TEMPERATURE_GPU)
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, uint32(50), temperature)
+
+	clock, ret := d.GetClockInfo(nvml.CLOCK_SM)
+	require.Equal(t, nvml.ERROR_TIMEOUT, ret)
+	require.Equal(t, uint32(0), clock)
+}
+
+func TestTimeoutInterfaceHandles(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	device := &mock.Device{}
+	gpuInstance := &mock.GpuInstance{
+		DestroyFunc: func() nvml.Return {
+			return nvml.SUCCESS
+		},
+		GetInfoFunc: func() (nvml.GpuInstanceInfo, nvml.Return) {
+			<-release
+			return nvml.GpuInstanceInfo{Id: 1}, nvml.SUCCESS
+		},
+	}
+	set := &mock.EventSet{
+		WaitFunc: func(uint32) (nvml.EventData, nvml.Return) {
+			<-release
+			return nvml.EventData{EventType: nvml.EventTypeXidCriticalError}, nvml.SUCCESS
+		},
+	}
+	lib := &mock.Interface{
+		DeviceGetHandleByIndexFunc: func(index int) (nvml.Device, nvml.Return) {
+			return device, nvml.SUCCESS
+		},
+		DeviceCreateGpuInstanceFunc: func(nvml.Device, *nvml.GpuInstanceProfileInfo) (nvml.GpuInstance, nvml.Return) {
+			return gpuInstance, nvml.SUCCESS
+		},
+		EventSetCreateFunc: func() (nvml.EventSet, nvml.Return) {
+			return set, nvml.SUCCESS
+		},
+		DeviceRegisterEventsFunc: func(d nvml.Device, eventTypes uint64, s nvml.EventSet) nvml.Return {
+			// Handles are unwrapped before they are passed on.
+			require.Same(t, device, d)
+			require.Same(t, set, s)
+			return nvml.SUCCESS
+		},
+	}
+
+	timeoutLib := nvml.NewTimeoutInterface(lib, 10*time.Millisecond)
+
+	d, ret := timeoutLib.DeviceGetHandleByIndex(0)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	gi, ret := timeoutLib.DeviceCreateGpuInstance(d, &nvml.GpuInstanceProfileInfo{})
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.SUCCESS, gi.Destroy())
+
+	info, ret := gi.GetInfo()
+	require.Equal(t, nvml.ERROR_TIMEOUT, ret)
+	require.Equal(t, nvml.GpuInstanceInfo{}, info)
+
+	s, ret := timeoutLib.EventSetCreate()
+	require.Equal(t, nvml.SUCCESS, ret)
+	require.Equal(t, nvml.SUCCESS, timeoutLib.DeviceRegisterEvents(d, nvml.EventTypeXidCriticalError, s))
+
+	data, ret := s.Wait(1000)
+	require.Equal(t, nvml.ERROR_TIMEOUT, ret)
+	require.Equal(t, nvml.EventData{}, data)
+}
@@ -0,0 +1,98 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+// TranscoderLoad combines the load of the video engines of a device into a
+// single score for load balancing. Each component is only valid if the
+// corresponding Available field is set.
+type TranscoderLoad struct {
+	// Score is the mean of the available components, normalized to 0-100.
+	Score float64
+	// EncoderCapacityUsed is the used H.264 encoder capacity in percent.
+	EncoderCapacityUsed          uint32
+	EncoderCapacityUsedAvailable bool
+	// DecoderUtilization is the decoder utilization in percent.
+	DecoderUtilization          uint32
+	DecoderUtilizationAvailable bool
+	// EncoderSessions is the number of active encoder sessions.
+	EncoderSessions          int
+	EncoderSessionsAvailable bool
+}
+
+// GetTranscoderLoad returns the TranscoderLoad of a device. Since NVML does
+// not report a session limit, the session count only contributes to the score
+// if maxSessions is positive, as the fraction of maxSessions in use.
+//
+// Components whose engine is not present are left out of the score rather
+// than counted as idle. If no component is available, ERROR_NOT_SUPPORTED is
+// returned; any other error is returned as is.
+func GetTranscoderLoad(device Device, maxSessions int) (TranscoderLoad, Return) {
+	var load TranscoderLoad
+	var components []float64
+
+	capacity, ret := device.GetEncoderCapacity(ENCODER_QUERY_H264)
+	if load.EncoderCapacityUsedAvailable, ret = available(ret); ret != SUCCESS {
+		return load, ret
+	}
+	if load.EncoderCapacityUsedAvailable {
+		// The capacity is reported as the remaining percentage.
+		load.EncoderCapacityUsed = uint32(100 - clampPercent(float64(capacity)))
+		components = append(components, float64(load.EncoderCapacityUsed))
+	}
+
+	utilization, _, ret := device.GetDecoderUtilization()
+	if load.DecoderUtilizationAvailable, ret = available(ret); ret != SUCCESS {
+		return load, ret
+	}
+	if load.DecoderUtilizationAvailable {
+		load.DecoderUtilization = utilization
+		components = append(components, clampPercent(float64(utilization)))
+	}
+
+	sessions, _, _, ret := device.GetEncoderStats()
+	if load.EncoderSessionsAvailable, ret = available(ret); ret != SUCCESS {
+		return load, ret
+	}
+	if load.EncoderSessionsAvailable {
+		load.EncoderSessions = sessions
+		if maxSessions > 0 {
+			components = append(components, clampPercent(100*float64(sessions)/float64(maxSessions)))
+		}
+	}
+
+	if len(components) == 0 {
+		return load, ERROR_NOT_SUPPORTED
+	}
+	var sum float64
+	for _, c := range components {
+		sum += c
+	}
+	load.Score = sum / float64(len(components))
+
+	return load, SUCCESS
+}
+
+// clampPercent clamps a percentage to 0-100.
+func clampPercent(p float64) float64 {
+	if p < 0 {
+		return 0
+	}
+	if p > 100 {
+		return 100
+	}
+	return p
+}
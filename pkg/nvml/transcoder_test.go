@@ -0,0 +1,125 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestGetTranscoderLoad(t *testing.T) {
+	testCases := []struct {
+		description  string
+		capacity     int
+		capacityRet  nvml.Return
+		decoder      uint32
+		decoderRet   nvml.Return
+		sessions     int
+		sessionsRet  nvml.Return
+		maxSessions  int
+		expectedLoad nvml.TranscoderLoad
+		expectedRet  nvml.Return
+	}{
+		{
+			description: "all engines",
+			capacity:    40,
+			decoder:     30,
+			sessions:    5,
+			maxSessions: 10,
+			expectedLoad: nvml.TranscoderLoad{
+				Score:                        (60 + 30 + 50) / 3.0,
+				EncoderCapacityUsed:          60,
+				EncoderCapacityUsedAvailable: true,
+				DecoderUtilization:           30,
+				DecoderUtilizationAvailable:  true,
+				EncoderSessions:              5,
+				EncoderSessionsAvailable:     true,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "no session limit",
+			capacity:    40,
+			decoder:     30,
+			sessions:    5,
+			expectedLoad: nvml.TranscoderLoad{
+				Score:                        45,
+				EncoderCapacityUsed:          60,
+				EncoderCapacityUsedAvailable: true,
+				DecoderUtilization:           30,
+				DecoderUtilizationAvailable:  true,
+				EncoderSessions:              5,
+				EncoderSessionsAvailable:     true,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "no encoder",
+			capacityRet: nvml.ERROR_NOT_SUPPORTED,
+			decoder:     80,
+			sessionsRet: nvml.ERROR_NOT_SUPPORTED,
+			maxSessions: 10,
+			expectedLoad: nvml.TranscoderLoad{
+				Score:                       80,
+				DecoderUtilization:          80,
+				DecoderUtilizationAvailable: true,
+			},
+			expectedRet: nvml.SUCCESS,
+		},
+		{
+			description: "no engines",
+			capacityRet: nvml.ERROR_NOT_SUPPORTED,
+			decoderRet:  nvml.ERROR_NOT_SUPPORTED,
+			sessionsRet: nvml.ERROR_NOT_SUPPORTED,
+			expectedRet: nvml.ERROR_NOT_SUPPORTED,
+		},
+		{
+			description: "error",
+			capacity:    40,
+			decoderRet:  nvml.ERROR_GPU_IS_LOST,
+			expectedLoad: nvml.TranscoderLoad{
+				EncoderCapacityUsed:          60,
+				EncoderCapacityUsedAvailable: true,
+			},
+			expectedRet: nvml.ERROR_GPU_IS_LOST,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			device := &mock.Device{
+				GetEncoderCapacityFunc: func(encoderQueryType nvml.EncoderType) (int, nvml.Return) {
+					return tc.capacity, tc.capacityRet
+				},
+				GetDecoderUtilizationFunc: func() (uint32, uint32, nvml.Return) {
+					return tc.decoder, 1000000, tc.decoderRet
+				},
+				GetEncoderStatsFunc: func() (int, uint32, uint32, nvml.Return) {
+					return tc.sessions, 30, 100, tc.sessionsRet
+				},
+			}
+
+			load, ret := nvml.GetTranscoderLoad(device, tc.maxSessions)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expectedLoad, load)
+		})
+	}
+}
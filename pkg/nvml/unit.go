@@ -111,3 +111,37 @@ func (l *library) UnitSetLedState(unit Unit, color LedColor) Return {
 func (unit nvmlUnit) SetLedState(color LedColor) Return {
 	return nvmlUnitSetLedState(unit, color)
 }
+
+// EachUnit calls fn for each S-class unit in the system, in index order. It
+// stops at, and returns, the first error returned by fn. Errors from NVML are
+// returned as a Return. On systems without units this is a no-op.
+func EachUnit(lib Interface, fn func(idx int, u Unit) error) error {
+	count, ret := lib.UnitGetCount()
+	if ret != SUCCESS {
+		return ret
+	}
+	for i := 0; i < count; i++ {
+		unit, ret := lib.UnitGetHandleByIndex(i)
+		if ret != SUCCESS {
+			return ret
+		}
+		if err := fn(i, unit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Units returns all S-class units in the system, in index order. On systems
+// without units an empty slice is returned.
+func Units(lib Interface) ([]Unit, error) {
+	units := []Unit{}
+	err := EachUnit(lib, func(_ int, u Unit) error {
+		units = append(units, u)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return units, nil
+}
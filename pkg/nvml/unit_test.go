@@ -0,0 +1,88 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestEachUnit(t *testing.T) {
+	units := []nvml.Unit{&mock.Unit{}, &mock.Unit{}, &mock.Unit{}}
+	lib := &mock.Interface{
+		UnitGetCountFunc: func() (int, nvml.Return) {
+			return len(units), nvml.SUCCESS
+		},
+		UnitGetHandleByIndexFunc: func(index int) (nvml.Unit, nvml.Return) {
+			return units[index], nvml.SUCCESS
+		},
+	}
+
+	var visited []int
+	err := nvml.EachUnit(lib, func(idx int, u nvml.Unit) error {
+		require.Same(t, units[idx], u)
+		visited = append(visited, idx)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 1, 2}, visited)
+
+	all, err := nvml.Units(lib)
+	require.NoError(t, err)
+	require.Equal(t, units, all)
+
+	errStop := errors.New("stop")
+	visited = nil
+	err = nvml.EachUnit(lib, func(idx int, u nvml.Unit) error {
+		visited = append(visited, idx)
+		if idx == 1 {
+			return errStop
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, errStop)
+	require.Equal(t, []int{0, 1}, visited)
+
+	lib.UnitGetHandleByIndexFunc = func(index int) (nvml.Unit, nvml.Return) {
+		return nil, nvml.ERROR_UNKNOWN
+	}
+	_, err = nvml.Units(lib)
+	require.ErrorIs(t, err, nvml.ERROR_UNKNOWN)
+}
+
+func TestEachUnitNoUnits(t *testing.T) {
+	lib := &mock.Interface{
+		UnitGetCountFunc: func() (int, nvml.Return) {
+			return 0, nvml.SUCCESS
+		},
+	}
+
+	err := nvml.EachUnit(lib, func(idx int, u nvml.Unit) error {
+		t.Fatal("unexpected unit")
+		return nil
+	})
+	require.NoError(t, err)
+
+	units, err := nvml.Units(lib)
+	require.NoError(t, err)
+	require.Empty(t, units)
+}
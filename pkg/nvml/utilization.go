@@ -0,0 +1,224 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"time"
+)
+
+// UtilizationSource indicates how a Utilization returned by
+// UtilizationRatesOrMIG was obtained.
+type UtilizationSource int32
+
+// UtilizationSource values.
+const (
+	// UTILIZATION_SOURCE_DEVICE indicates the value was reported by
+	// GetUtilizationRates.
+	UTILIZATION_SOURCE_DEVICE UtilizationSource = iota
+	// UTILIZATION_SOURCE_GPM indicates the value was derived from the SM and
+	// DRAM bandwidth utilization GPM metrics of the MIG device's GPU instance.
+	UTILIZATION_SOURCE_GPM
+	// UTILIZATION_SOURCE_PROCESS indicates the value was derived by summing the
+	// latest utilization sample of each process on the MIG device.
+	UTILIZATION_SOURCE_PROCESS
+)
+
+// UtilizationRatesOrMIG returns the utilization of a device. For MIG devices,
+// where GetUtilizationRates is not supported, a best-effort value is derived
+// from GPM metrics sampled across the specified window, or failing that from
+// the per-process utilization of the MIG device. ERROR_NOT_SUPPORTED is
+// returned if none of these sources are available.
+func UtilizationRatesOrMIG(lib Interface, device Device, window time.Duration) (Utilization, UtilizationSource, Return) {
+	utilization, ret := device.GetUtilizationRates()
+	if ret == SUCCESS {
+		return utilization, UTILIZATION_SOURCE_DEVICE, ret
+	}
+	if !isUnsupported(ret) {
+		return utilization, UTILIZATION_SOURCE_DEVICE, ret
+	}
+
+	isMig, ret := device.IsMigDeviceHandle()
+	if ret != SUCCESS && !isUnsupported(ret) {
+		return Utilization{}, UTILIZATION_SOURCE_DEVICE, ret
+	}
+	if !isMig {
+		return Utilization{}, UTILIZATION_SOURCE_DEVICE, ERROR_NOT_SUPPORTED
+	}
+
+	if utilization, ret := migGpmUtilization(lib, device, window); ret == SUCCESS {
+		return utilization, UTILIZATION_SOURCE_GPM, ret
+	}
+
+	samples, ret := device.GetProcessUtilization(0)
+	if ret == ERROR_NOT_FOUND {
+		// No process has been active on the device since the last sample.
+		return Utilization{}, UTILIZATION_SOURCE_PROCESS, SUCCESS
+	}
+	if isUnsupported(ret) {
+		return Utilization{}, UTILIZATION_SOURCE_DEVICE, ERROR_NOT_SUPPORTED
+	}
+	if ret != SUCCESS {
+		return Utilization{}, UTILIZATION_SOURCE_DEVICE, ret
+	}
+	for _, sample := range latestProcessUtilization(samples) {
+		utilization.Gpu += sample.SmUtil
+		utilization.Memory += sample.MemUtil
+	}
+	if utilization.Gpu > 100 {
+		utilization.Gpu = 100
+	}
+	if utilization.Memory > 100 {
+		utilization.Memory = 100
+	}
+	return utilization, UTILIZATION_SOURCE_PROCESS, SUCCESS
+}
+
+// migGpmUtilization samples the GPM metrics of the GPU instance backing a MIG
+// device across the specified window.
+func migGpmUtilization(lib Interface, device Device, window time.Duration) (Utilization, Return) {
+	parent, ret := device.GetDeviceHandleFromMigDeviceHandle()
+	if ret != SUCCESS {
+		return Utilization{}, ret
+	}
+	gpuInstanceId, ret := device.GetGpuInstanceId()
+	if ret != SUCCESS {
+		return Utilization{}, ret
+	}
+	support, ret := parent.GpmQueryDeviceSupport()
+	if ret != SUCCESS {
+		return Utilization{}, ret
+	}
+	if support.IsSupportedDevice == 0 {
+		return Utilization{}, ERROR_NOT_SUPPORTED
+	}
+
+	sample1, ret := lib.GpmSampleAlloc()
+	if ret != SUCCESS {
+		return Utilization{}, ret
+	}
+	defer sample1.Free()
+	sample2, ret := lib.GpmSampleAlloc()
+	if ret != SUCCESS {
+		return Utilization{}, ret
+	}
+	defer sample2.Free()
+
+	if ret := sample1.MigGet(parent, gpuInstanceId); ret != SUCCESS {
+		return Utilization{}, ret
+	}
+	time.Sleep(window)
+	if ret := sample2.MigGet(parent, gpuInstanceId); ret != SUCCESS {
+		return Utilization{}, ret
+	}
+
+	metricsGet := GpmMetricsGetType{
+		NumMetrics: 2,
+		Sample1:    sample1,
+		Sample2:    sample2,
+	}
+	metricsGet.Metrics[0].MetricId = uint32(GPM_METRIC_SM_UTIL)
+	metricsGet.Metrics[1].MetricId = uint32(GPM_METRIC_DRAM_BW_UTIL)
+	if ret := lib.GpmMetricsGet(&metricsGet); ret != SUCCESS {
+		return Utilization{}, ret
+	}
+	for _, metric := range metricsGet.Metrics[:2] {
+		if Return(metric.NvmlReturn) != SUCCESS {
+			return Utilization{}, Return(metric.NvmlReturn)
+		}
+	}
+
+	return Utilization{
+		Gpu:    uint32(metricsGet.Metrics[0].Value),
+		Memory: uint32(metricsGet.Metrics[1].Value),
+	}, SUCCESS
+}
+
+// smoothedUtilizationMaxFailures is the number of consecutive failed reads
+// after which a SmoothedUtilization discards its state.
+const smoothedUtilizationMaxFailures = 3
+
+// SmoothedUtilization maintains an exponential moving average of the GPU and
+// memory utilization of a device, as reported by GetUtilizationRates.
+type SmoothedUtilization struct {
+	device      Device
+	alpha       float64
+	gpu         float64
+	memory      float64
+	initialized bool
+	failures    int
+}
+
+// NewSmoothedUtilization creates a SmoothedUtilization for device. Each
+// sample is weighted by alpha, which is clamped to (0, 1]; smaller values give
+// smoother averages that react more slowly. A value of 1 disables smoothing.
+func NewSmoothedUtilization(device Device, alpha float64) *SmoothedUtilization {
+	if alpha <= 0 || alpha > 1 {
+		alpha = 1
+	}
+	return &SmoothedUtilization{
+		device: device,
+		alpha:  alpha,
+	}
+}
+
+// Sample reads the utilization of the device and folds it into the averages.
+// The first successful read after creation or a reset initializes the
+// averages to the value read. A failed read leaves the averages unchanged and
+// its error is returned; after three consecutive failures the state is reset,
+// so stale averages are not used once reads succeed again.
+func (s *SmoothedUtilization) Sample() Return {
+	utilization, ret := s.device.GetUtilizationRates()
+	if ret != SUCCESS {
+		s.failures++
+		if s.failures >= smoothedUtilizationMaxFailures {
+			s.Reset()
+		}
+		return ret
+	}
+	s.failures = 0
+
+	if !s.initialized {
+		s.gpu = float64(utilization.Gpu)
+		s.memory = float64(utilization.Memory)
+		s.initialized = true
+		return SUCCESS
+	}
+	s.gpu += s.alpha * (float64(utilization.Gpu) - s.gpu)
+	s.memory += s.alpha * (float64(utilization.Memory) - s.memory)
+	return SUCCESS
+}
+
+// Gpu returns the smoothed GPU utilization in percent. The returned bool is
+// false if no sample has been taken since creation or the last reset.
+func (s *SmoothedUtilization) Gpu() (float64, bool) {
+	return s.gpu, s.initialized
+}
+
+// Memory returns the smoothed memory utilization in percent. The returned
+// bool is false if no sample has been taken since creation or the last reset.
+func (s *SmoothedUtilization) Memory() (float64, bool) {
+	return s.memory, s.initialized
+}
+
+// Reset discards the averages so that the next successful sample
+// initializes them afresh.
+func (s *SmoothedUtilization) Reset() {
+	s.gpu = 0
+	s.memory = 0
+	s.initialized = false
+	s.failures = 0
+}
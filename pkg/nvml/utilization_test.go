@@ -0,0 +1,218 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestUtilizationRatesOrMIG(t *testing.T) {
+	newMigDevice := func(gpmSupported uint32, processUtilizationRet nvml.Return, samples ...nvml.ProcessUtilizationSample) *mock.Device {
+		parent := &mock.Device{
+			GpmQueryDeviceSupportFunc: func() (nvml.GpmSupport, nvml.Return) {
+				return nvml.GpmSupport{IsSupportedDevice: gpmSupported}, nvml.SUCCESS
+			},
+		}
+		return &mock.Device{
+			GetUtilizationRatesFunc: func() (nvml.Utilization, nvml.Return) {
+				return nvml.Utilization{}, nvml.ERROR_NOT_SUPPORTED
+			},
+			IsMigDeviceHandleFunc: func() (bool, nvml.Return) {
+				return true, nvml.SUCCESS
+			},
+			GetDeviceHandleFromMigDeviceHandleFunc: func() (nvml.Device, nvml.Return) {
+				return parent, nvml.SUCCESS
+			},
+			GetGpuInstanceIdFunc: func() (int, nvml.Return) {
+				return 3, nvml.SUCCESS
+			},
+			GetProcessUtilizationFunc: func(uint64) ([]nvml.ProcessUtilizationSample, nvml.Return) {
+				return samples, processUtilizationRet
+			},
+		}
+	}
+
+	var migGetCalls []int
+	lib := &mock.Interface{
+		GpmSampleAllocFunc: func() (nvml.GpmSample, nvml.Return) {
+			sample := &mock.GpmSample{
+				MigGetFunc: func(device nvml.Device, gpuInstanceId int) nvml.Return {
+					migGetCalls = append(migGetCalls, gpuInstanceId)
+					return nvml.SUCCESS
+				},
+				FreeFunc: func() nvml.Return {
+					return nvml.SUCCESS
+				},
+			}
+			return sample, nvml.SUCCESS
+		},
+		GpmMetricsGetFunc: func(metricsGet *nvml.GpmMetricsGetType) nvml.Return {
+			for i := range metricsGet.Metrics[:metricsGet.NumMetrics] {
+				switch nvml.GpmMetricId(metricsGet.Metrics[i].MetricId) {
+				case nvml.GPM_METRIC_SM_UTIL:
+					metricsGet.Metrics[i].Value = 42.5
+				case nvml.GPM_METRIC_DRAM_BW_UTIL:
+					metricsGet.Metrics[i].Value = 17
+				}
+			}
+			return nvml.SUCCESS
+		},
+	}
+
+	testCases := []struct {
+		description         string
+		device              *mock.Device
+		expected            nvml.Utilization
+		expectedSource      nvml.UtilizationSource
+		expectedRet         nvml.Return
+		expectedMigGetCalls []int
+	}{
+		{
+			description: "physical device",
+			device: &mock.Device{
+				GetUtilizationRatesFunc: func() (nvml.Utilization, nvml.Return) {
+					return nvml.Utilization{Gpu: 80, Memory: 30}, nvml.SUCCESS
+				},
+			},
+			expected:       nvml.Utilization{Gpu: 80, Memory: 30},
+			expectedSource: nvml.UTILIZATION_SOURCE_DEVICE,
+			expectedRet:    nvml.SUCCESS,
+		},
+		{
+			description: "unsupported physical device",
+			device: &mock.Device{
+				GetUtilizationRatesFunc: func() (nvml.Utilization, nvml.Return) {
+					return nvml.Utilization{}, nvml.ERROR_NOT_SUPPORTED
+				},
+				IsMigDeviceHandleFunc: func() (bool, nvml.Return) {
+					return false, nvml.SUCCESS
+				},
+			},
+			expectedSource: nvml.UTILIZATION_SOURCE_DEVICE,
+			expectedRet:    nvml.ERROR_NOT_SUPPORTED,
+		},
+		{
+			description:         "MIG device with GPM",
+			device:              newMigDevice(1, nvml.SUCCESS),
+			expected:            nvml.Utilization{Gpu: 42, Memory: 17},
+			expectedSource:      nvml.UTILIZATION_SOURCE_GPM,
+			expectedRet:         nvml.SUCCESS,
+			expectedMigGetCalls: []int{3, 3},
+		},
+		{
+			description: "MIG device with process utilization",
+			device: newMigDevice(0, nvml.SUCCESS,
+				nvml.ProcessUtilizationSample{Pid: 1, SmUtil: 20, MemUtil: 5},
+				nvml.ProcessUtilizationSample{Pid: 2, SmUtil: 30, MemUtil: 10},
+			),
+			expected:       nvml.Utilization{Gpu: 50, Memory: 15},
+			expectedSource: nvml.UTILIZATION_SOURCE_PROCESS,
+			expectedRet:    nvml.SUCCESS,
+		},
+		{
+			description: "MIG device with repeated process samples",
+			device: newMigDevice(0, nvml.SUCCESS,
+				nvml.ProcessUtilizationSample{Pid: 1, TimeStamp: 10, SmUtil: 60, MemUtil: 40},
+				nvml.ProcessUtilizationSample{Pid: 2, TimeStamp: 15, SmUtil: 30, MemUtil: 10},
+				nvml.ProcessUtilizationSample{Pid: 1, TimeStamp: 30, SmUtil: 20, MemUtil: 5},
+				nvml.ProcessUtilizationSample{Pid: 1, TimeStamp: 20, SmUtil: 70, MemUtil: 50},
+			),
+			expected:       nvml.Utilization{Gpu: 50, Memory: 15},
+			expectedSource: nvml.UTILIZATION_SOURCE_PROCESS,
+			expectedRet:    nvml.SUCCESS,
+		},
+		{
+			description:    "MIG device without GPM or process utilization",
+			device:         newMigDevice(0, nvml.ERROR_NOT_SUPPORTED),
+			expectedSource: nvml.UTILIZATION_SOURCE_DEVICE,
+			expectedRet:    nvml.ERROR_NOT_SUPPORTED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			migGetCalls = nil
+
+			utilization, source, ret := nvml.UtilizationRatesOrMIG(lib, tc.device, 0)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expected, utilization)
+			require.Equal(t, tc.expectedSource, source)
+			require.Equal(t, tc.expectedMigGetCalls, migGetCalls)
+		})
+	}
+}
+
+func TestSmoothedUtilization(t *testing.T) {
+	var reading nvml.Utilization
+	var readingRet nvml.Return
+	device := &mock.Device{
+		GetUtilizationRatesFunc: func() (nvml.Utilization, nvml.Return) {
+			return reading, readingRet
+		},
+	}
+
+	s := nvml.NewSmoothedUtilization(device, 0.5)
+	_, ok := s.Gpu()
+	require.False(t, ok)
+
+	reading = nvml.Utilization{Gpu: 0, Memory: 40}
+	require.Equal(t, nvml.SUCCESS, s.Sample())
+
+	// With a step to 100, the distance to the new value halves with each
+	// sample.
+	reading = nvml.Utilization{Gpu: 100, Memory: 40}
+	for _, expected := range []float64{50, 75, 87.5, 93.75} {
+		require.Equal(t, nvml.SUCCESS, s.Sample())
+		gpu, ok := s.Gpu()
+		require.True(t, ok)
+		require.InDelta(t, expected, gpu, 1e-9)
+		memory, ok := s.Memory()
+		require.True(t, ok)
+		require.InDelta(t, 40, memory, 1e-9)
+	}
+	for i := 0; i < 20; i++ {
+		require.Equal(t, nvml.SUCCESS, s.Sample())
+	}
+	gpu, _ := s.Gpu()
+	require.InDelta(t, 100, gpu, 1e-3)
+
+	// Isolated failures leave the averages untouched.
+	readingRet = nvml.ERROR_UNKNOWN
+	require.Equal(t, nvml.ERROR_UNKNOWN, s.Sample())
+	require.Equal(t, nvml.ERROR_UNKNOWN, s.Sample())
+	gpu, ok = s.Gpu()
+	require.True(t, ok)
+	require.InDelta(t, 100, gpu, 1e-3)
+
+	// A third consecutive failure resets the state.
+	require.Equal(t, nvml.ERROR_UNKNOWN, s.Sample())
+	_, ok = s.Gpu()
+	require.False(t, ok)
+
+	readingRet = nvml.SUCCESS
+	reading = nvml.Utilization{Gpu: 10, Memory: 20}
+	require.Equal(t, nvml.SUCCESS, s.Sample())
+	gpu, _ = s.Gpu()
+	require.Equal(t, float64(10), gpu)
+	memory, _ := s.Memory()
+	require.Equal(t, float64(20), memory)
+}
@@ -0,0 +1,88 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"time"
+	"unsafe"
+)
+
+// valueAsFloat64 decodes an nvmlValue_t union of the specified type, as
+// embedded in Sample, FieldValue and other structs, as a float64. The union
+// is stored in host byte order. unsigned long is 64 bits wide on all
+// platforms supported by this package.
+func valueAsFloat64(valueType ValueType, value [8]byte) (float64, bool) {
+	p := unsafe.Pointer(&value[0])
+	switch valueType {
+	case VALUE_TYPE_DOUBLE:
+		return *(*float64)(p), true
+	case VALUE_TYPE_UNSIGNED_INT:
+		return float64(*(*uint32)(p)), true
+	case VALUE_TYPE_UNSIGNED_LONG, VALUE_TYPE_UNSIGNED_LONG_LONG:
+		return float64(*(*uint64)(p)), true
+	case VALUE_TYPE_SIGNED_LONG_LONG:
+		return float64(*(*int64)(p)), true
+	case VALUE_TYPE_SIGNED_INT:
+		return float64(*(*int32)(p)), true
+	default:
+		return 0, false
+	}
+}
+
+// AsFloat64 returns the value of a field of type VALUE_TYPE_DOUBLE. The
+// returned bool is false if the field holds a value of another type.
+func (f FieldValue) AsFloat64() (float64, bool) {
+	if ValueType(f.ValueType) != VALUE_TYPE_DOUBLE {
+		return 0, false
+	}
+	return valueAsFloat64(VALUE_TYPE_DOUBLE, f.Value)
+}
+
+// AsInt64 returns the value of a field of a signed integer type. The returned
+// bool is false if the field holds a value of another type.
+func (f FieldValue) AsInt64() (int64, bool) {
+	p := unsafe.Pointer(&f.Value[0])
+	switch ValueType(f.ValueType) {
+	case VALUE_TYPE_SIGNED_LONG_LONG:
+		return *(*int64)(p), true
+	case VALUE_TYPE_SIGNED_INT:
+		return int64(*(*int32)(p)), true
+	default:
+		return 0, false
+	}
+}
+
+// AsUint64 returns the value of a field of an unsigned integer type. The
+// returned bool is false if the field holds a value of another type.
+func (f FieldValue) AsUint64() (uint64, bool) {
+	p := unsafe.Pointer(&f.Value[0])
+	switch ValueType(f.ValueType) {
+	case VALUE_TYPE_UNSIGNED_INT:
+		return uint64(*(*uint32)(p)), true
+	case VALUE_TYPE_UNSIGNED_LONG, VALUE_TYPE_UNSIGNED_LONG_LONG:
+		return *(*uint64)(p), true
+	default:
+		return 0, false
+	}
+}
+
+// Time returns the CPU timestamp at which the field was read. NVML reports
+// the timestamp in microseconds since the Unix epoch. The accessor cannot be
+// named Timestamp since that is the name of the underlying field.
+func (f FieldValue) Time() time.Time {
+	return time.UnixMicro(f.Timestamp)
+}
@@ -0,0 +1,99 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+)
+
+func TestFieldValueAccessors(t *testing.T) {
+	fieldValue := func(valueType nvml.ValueType, put func(b []byte)) nvml.FieldValue {
+		f := nvml.FieldValue{ValueType: uint32(valueType)}
+		put(f.Value[:])
+		return f
+	}
+
+	double := fieldValue(nvml.VALUE_TYPE_DOUBLE, func(b []byte) {
+		binary.LittleEndian.PutUint64(b, math.Float64bits(42.5))
+	})
+	unsignedInt := fieldValue(nvml.VALUE_TYPE_UNSIGNED_INT, func(b []byte) {
+		binary.LittleEndian.PutUint32(b, math.MaxUint32)
+	})
+	unsignedLong := fieldValue(nvml.VALUE_TYPE_UNSIGNED_LONG, func(b []byte) {
+		binary.LittleEndian.PutUint64(b, 1<<40)
+	})
+	unsignedLongLong := fieldValue(nvml.VALUE_TYPE_UNSIGNED_LONG_LONG, func(b []byte) {
+		binary.LittleEndian.PutUint64(b, math.MaxUint64)
+	})
+	signedLongLong := fieldValue(nvml.VALUE_TYPE_SIGNED_LONG_LONG, func(b []byte) {
+		binary.LittleEndian.PutUint64(b, uint64(math.MaxUint64-9)) // -10
+	})
+	signedInt := fieldValue(nvml.VALUE_TYPE_SIGNED_INT, func(b []byte) {
+		binary.LittleEndian.PutUint32(b, uint32(math.MaxUint32-4)) // -5
+	})
+
+	testCases := []struct {
+		description   string
+		value         nvml.FieldValue
+		expectedFloat interface{}
+		expectedInt   interface{}
+		expectedUint  interface{}
+	}{
+		{"double", double, 42.5, nil, nil},
+		{"unsigned int", unsignedInt, nil, nil, uint64(math.MaxUint32)},
+		{"unsigned long", unsignedLong, nil, nil, uint64(1 << 40)},
+		{"unsigned long long", unsignedLongLong, nil, nil, uint64(math.MaxUint64)},
+		{"signed long long", signedLongLong, nil, int64(-10), nil},
+		{"signed int", signedInt, nil, int64(-5), nil},
+		{"invalid", nvml.FieldValue{ValueType: uint32(nvml.VALUE_TYPE_COUNT)}, nil, nil, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			f, ok := tc.value.AsFloat64()
+			require.Equal(t, tc.expectedFloat != nil, ok)
+			if ok {
+				require.Equal(t, tc.expectedFloat, f)
+			}
+
+			i, ok := tc.value.AsInt64()
+			require.Equal(t, tc.expectedInt != nil, ok)
+			if ok {
+				require.Equal(t, tc.expectedInt, i)
+			}
+
+			u, ok := tc.value.AsUint64()
+			require.Equal(t, tc.expectedUint != nil, ok)
+			if ok {
+				require.Equal(t, tc.expectedUint, u)
+			}
+		})
+	}
+}
+
+func TestFieldValueTime(t *testing.T) {
+	timestamp := time.Date(2024, 5, 1, 12, 0, 0, 123456000, time.UTC)
+	f := nvml.FieldValue{Timestamp: timestamp.UnixMicro()}
+	require.True(t, timestamp.Equal(f.Time()))
+}
@@ -0,0 +1,83 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NVMLVersion is a parsed NVML version as returned by SystemGetNVMLVersion,
+// e.g. "12.535.129.03", where the first component is the CUDA major version
+// and the remaining ones are the driver version.
+type NVMLVersion struct {
+	raw        string
+	components []int
+}
+
+// ParseNVMLVersion parses a dot-separated NVML version string.
+func ParseNVMLVersion(version string) (NVMLVersion, error) {
+	if version == "" {
+		return NVMLVersion{}, fmt.Errorf("empty NVML version")
+	}
+
+	parts := strings.Split(version, ".")
+	components := make([]int, len(parts))
+	for i, part := range parts {
+		c, err := strconv.Atoi(part)
+		if err != nil || c < 0 {
+			return NVMLVersion{}, fmt.Errorf("invalid component %q in NVML version %q", part, version)
+		}
+		components[i] = c
+	}
+
+	return NVMLVersion{raw: version, components: components}, nil
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is lower than, equal to
+// or higher than other. Components are compared numerically, so that
+// "12.535.129.03" equals "12.535.129.3"; missing trailing components are
+// treated as zero.
+func (v NVMLVersion) Compare(other NVMLVersion) int {
+	n := len(v.components)
+	if len(other.components) > n {
+		n = len(other.components)
+	}
+	for i := 0; i < n; i++ {
+		a, b := v.component(i), other.component(i)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+	}
+	return 0
+}
+
+// String returns the version as it was parsed.
+func (v NVMLVersion) String() string {
+	return v.raw
+}
+
+func (v NVMLVersion) component(i int) int {
+	if i < len(v.components) {
+		return v.components[i]
+	}
+	return 0
+}
@@ -0,0 +1,60 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+)
+
+func TestNVMLVersionCompare(t *testing.T) {
+	testCases := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"12.535.129.03", "12.535.129.03", 0},
+		{"12.535.129.03", "12.535.129.3", 0},
+		{"12.535.129.03", "12.550.54.14", -1},
+		{"12.550.54.14", "11.535.129.03", 1},
+		{"12.535.104", "12.535.104.05", -1},
+		{"12.535.104.0", "12.535.104", 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a+" vs "+tc.b, func(t *testing.T) {
+			a, err := nvml.ParseNVMLVersion(tc.a)
+			require.NoError(t, err)
+			b, err := nvml.ParseNVMLVersion(tc.b)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected, a.Compare(b))
+			require.Equal(t, -tc.expected, b.Compare(a))
+			require.Equal(t, tc.a, a.String())
+		})
+	}
+}
+
+func TestParseNVMLVersionInvalid(t *testing.T) {
+	for _, version := range []string{"", "12..129", "12.535.beta", "12.-1"} {
+		_, err := nvml.ParseNVMLVersion(version)
+		require.Error(t, err, version)
+	}
+}
@@ -153,10 +153,13 @@ func (l *library) VgpuTypeGetMaxInstancesPerVm(vgpuTypeId VgpuTypeId) (int, Retu
 
 func (vgpuTypeId nvmlVgpuTypeId) GetMaxInstancesPerVm() (int, Return) {
 	var vgpuInstanceCountPerVm uint32
-	ret := nvmlVgpuTypeGetMaxInstancesPerVm(vgpuTypeId, &vgpuInstanceCountPerVm)
+	ret := nvmlVgpuTypeGetMaxInstancesPerVmStub(vgpuTypeId, &vgpuInstanceCountPerVm)
 	return int(vgpuInstanceCountPerVm), ret
 }
 
+// nvmlVgpuTypeGetMaxInstancesPerVmStub allows us to override this for testing.
+var nvmlVgpuTypeGetMaxInstancesPerVmStub = nvmlVgpuTypeGetMaxInstancesPerVm
+
 // nvml.VgpuInstanceGetVmID()
 func (l *library) VgpuInstanceGetVmID(vgpuInstance VgpuInstance) (string, VgpuVmIdType, Return) {
 	return vgpuInstance.GetVmID()
@@ -392,6 +395,9 @@ func (vgpuInstance nvmlVgpuInstance) GetAccountingMode() (EnableState, Return) {
 }
 
 // nvml.VgpuInstanceGetAccountingPids()
+//
+// Only processes that ran on the vGPU instance while accounting mode was
+// enabled are returned.
 func (l *library) VgpuInstanceGetAccountingPids(vgpuInstance VgpuInstance) ([]int, Return) {
 	return vgpuInstance.GetAccountingPids()
 }
@@ -400,7 +406,7 @@ func (vgpuInstance nvmlVgpuInstance) GetAccountingPids() ([]int, Return) {
 	var count uint32 = 1 // Will be reduced upon returning
 	for {
 		pids := make([]uint32, count)
-		ret := nvmlVgpuInstanceGetAccountingPids(vgpuInstance, &count, &pids[0])
+		ret := nvmlVgpuInstanceGetAccountingPidsStub(vgpuInstance, &count, &pids[0])
 		if ret == SUCCESS {
 			return uint32SliceToIntSlice(pids[:count]), ret
 		}
@@ -411,24 +417,36 @@ func (vgpuInstance nvmlVgpuInstance) GetAccountingPids() ([]int, Return) {
 	}
 }
 
+// nvmlVgpuInstanceGetAccountingPidsStub allows us to override this for testing.
+var nvmlVgpuInstanceGetAccountingPidsStub = nvmlVgpuInstanceGetAccountingPids
+
 // nvml.VgpuInstanceGetAccountingStats()
+//
+// ERROR_NOT_FOUND is returned for a pid that has no accounting stats recorded
+// on the vGPU instance. Use GetAccountingPids to list the pids that do.
 func (l *library) VgpuInstanceGetAccountingStats(vgpuInstance VgpuInstance, pid int) (AccountingStats, Return) {
 	return vgpuInstance.GetAccountingStats(pid)
 }
 
 func (vgpuInstance nvmlVgpuInstance) GetAccountingStats(pid int) (AccountingStats, Return) {
 	var stats AccountingStats
-	ret := nvmlVgpuInstanceGetAccountingStats(vgpuInstance, uint32(pid), &stats)
+	ret := nvmlVgpuInstanceGetAccountingStatsStub(vgpuInstance, uint32(pid), &stats)
 	return stats, ret
 }
 
+// nvmlVgpuInstanceGetAccountingStatsStub allows us to override this for testing.
+var nvmlVgpuInstanceGetAccountingStatsStub = nvmlVgpuInstanceGetAccountingStats
+
 // nvml.GetVgpuCompatibility()
 func (l *library) GetVgpuCompatibility(vgpuMetadata *VgpuMetadata, pgpuMetadata *VgpuPgpuMetadata) (VgpuPgpuCompatibility, Return) {
 	var compatibilityInfo VgpuPgpuCompatibility
-	ret := nvmlGetVgpuCompatibility(&vgpuMetadata.nvmlVgpuMetadata, &pgpuMetadata.nvmlVgpuPgpuMetadata, &compatibilityInfo)
+	ret := nvmlGetVgpuCompatibilityStub(&vgpuMetadata.nvmlVgpuMetadata, &pgpuMetadata.nvmlVgpuPgpuMetadata, &compatibilityInfo)
 	return compatibilityInfo, ret
 }
 
+// nvmlGetVgpuCompatibilityStub allows us to override this for testing.
+var nvmlGetVgpuCompatibilityStub = nvmlGetVgpuCompatibility
+
 // nvml.GetVgpuVersion()
 func (l *library) GetVgpuVersion() (VgpuVersion, VgpuVersion, Return) {
 	var supported, current VgpuVersion
@@ -478,3 +496,90 @@ func (l *library) GetVgpuDriverCapabilities(capability VgpuDriverCapability) (bo
 	ret := nvmlGetVgpuDriverCapabilities(capability, &capResult)
 	return (capResult != 0), ret
 }
+
+// nvml.VgpuInstanceGetUtilization()
+//
+// NVML only exposes vGPU utilization per device, so the samples that the
+// parent device reports through GetVgpuUtilization(lastSeenTimestamp) are
+// filtered down to the most recent one for this instance. The parent device is
+// the one whose GetActiveVgpus lists the instance. An empty sample with
+// SUCCESS is returned if the instance was idle since lastSeenTimestamp;
+// ERROR_NOT_FOUND is returned only if no device lists the instance as active
+// any more, e.g. because it has since been destroyed.
+func (l *library) VgpuInstanceGetUtilization(vgpuInstance VgpuInstance, lastSeenTimestamp uint64) (ValueType, VgpuInstanceUtilizationSample, Return) {
+	return vgpuInstance.GetUtilization(lastSeenTimestamp)
+}
+
+func (vgpuInstance nvmlVgpuInstance) GetUtilization(lastSeenTimestamp uint64) (ValueType, VgpuInstanceUtilizationSample, Return) {
+	device, ret := vgpuInstance.parentDevice()
+	if ret != SUCCESS {
+		return 0, VgpuInstanceUtilizationSample{}, ret
+	}
+	return vgpuInstanceUtilization(device, vgpuInstance, lastSeenTimestamp)
+}
+
+// parentDevice returns the device on which a vGPU instance is active. The
+// GPU PCI id of the instance cannot be used to find it, since that is the id
+// as seen inside the VM. Devices without vGPU support are skipped.
+// ERROR_NOT_FOUND is returned if no device lists the instance.
+func (vgpuInstance nvmlVgpuInstance) parentDevice() (Device, Return) {
+	count, ret := libnvml.DeviceGetCount()
+	if ret != SUCCESS {
+		return nil, ret
+	}
+	for i := 0; i < count; i++ {
+		device, ret := libnvml.DeviceGetHandleByIndex(i)
+		if ret != SUCCESS {
+			return nil, ret
+		}
+		active, ret := device.GetActiveVgpus()
+		if isUnsupported(ret) {
+			continue
+		}
+		if ret != SUCCESS {
+			return nil, ret
+		}
+		for _, instance := range active {
+			if instance == VgpuInstance(vgpuInstance) {
+				return device, SUCCESS
+			}
+		}
+	}
+	return nil, ERROR_NOT_FOUND
+}
+
+// vgpuInstanceUtilization returns the most recent utilization sample that
+// device reports for vgpuInstance.
+func vgpuInstanceUtilization(device Device, vgpuInstance nvmlVgpuInstance, lastSeenTimestamp uint64) (ValueType, VgpuInstanceUtilizationSample, Return) {
+	var sample VgpuInstanceUtilizationSample
+	sampleValType, samples, ret := device.GetVgpuUtilization(lastSeenTimestamp)
+	if ret != SUCCESS {
+		return sampleValType, sample, ret
+	}
+
+	found := false
+	for _, s := range samples {
+		if s.VgpuInstance != uint32(vgpuInstance) {
+			continue
+		}
+		if !found || s.TimeStamp > sample.TimeStamp {
+			sample = s
+			found = true
+		}
+	}
+	if found {
+		return sampleValType, sample, SUCCESS
+	}
+
+	// No sample in the window: tell an idle instance from a destroyed one.
+	active, ret := device.GetActiveVgpus()
+	if ret != SUCCESS {
+		return sampleValType, sample, ret
+	}
+	for _, instance := range active {
+		if instance == VgpuInstance(vgpuInstance) {
+			return sampleValType, sample, SUCCESS
+		}
+	}
+	return sampleValType, sample, ERROR_NOT_FOUND
+}
@@ -0,0 +1,329 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/require"
+)
+
+type vgpuUtilizationDevice struct {
+	Device
+	sampleValType ValueType
+	samples       []VgpuInstanceUtilizationSample
+	active        []VgpuInstance
+	ret           Return
+}
+
+func (d vgpuUtilizationDevice) GetVgpuUtilization(uint64) (ValueType, []VgpuInstanceUtilizationSample, Return) {
+	return d.sampleValType, d.samples, d.ret
+}
+
+func (d vgpuUtilizationDevice) GetActiveVgpus() ([]VgpuInstance, Return) {
+	return d.active, SUCCESS
+}
+
+func TestVgpuInstanceGetUtilization(t *testing.T) {
+	samples := []VgpuInstanceUtilizationSample{
+		{VgpuInstance: 1, TimeStamp: 10, SmUtil: [8]byte{10}},
+		{VgpuInstance: 2, TimeStamp: 10, SmUtil: [8]byte{20}},
+		{VgpuInstance: 1, TimeStamp: 30, SmUtil: [8]byte{30}},
+		{VgpuInstance: 1, TimeStamp: 20, SmUtil: [8]byte{40}},
+	}
+
+	testCases := []struct {
+		description    string
+		device         Device
+		instance       nvmlVgpuInstance
+		expectedSample VgpuInstanceUtilizationSample
+		expectedRet    Return
+	}{
+		{
+			description:    "latest sample is returned",
+			device:         vgpuUtilizationDevice{sampleValType: VALUE_TYPE_UNSIGNED_INT, samples: samples},
+			instance:       nvmlVgpuInstance(1),
+			expectedSample: samples[2],
+			expectedRet:    SUCCESS,
+		},
+		{
+			description:    "single sample is returned",
+			device:         vgpuUtilizationDevice{sampleValType: VALUE_TYPE_UNSIGNED_INT, samples: samples},
+			instance:       nvmlVgpuInstance(2),
+			expectedSample: samples[1],
+			expectedRet:    SUCCESS,
+		},
+		{
+			description: "idle instance returns an empty sample",
+			device: vgpuUtilizationDevice{
+				sampleValType: VALUE_TYPE_UNSIGNED_INT,
+				samples:       samples,
+				active:        []VgpuInstance{nvmlVgpuInstance(1), nvmlVgpuInstance(2), nvmlVgpuInstance(3)},
+			},
+			instance:    nvmlVgpuInstance(3),
+			expectedRet: SUCCESS,
+		},
+		{
+			description: "no samples since the last seen timestamp",
+			device: vgpuUtilizationDevice{
+				sampleValType: VALUE_TYPE_UNSIGNED_INT,
+				samples:       []VgpuInstanceUtilizationSample{},
+				active:        []VgpuInstance{nvmlVgpuInstance(1)},
+			},
+			instance:    nvmlVgpuInstance(1),
+			expectedRet: SUCCESS,
+		},
+		{
+			description: "destroyed instance is not found",
+			device: vgpuUtilizationDevice{
+				sampleValType: VALUE_TYPE_UNSIGNED_INT,
+				samples:       samples,
+				active:        []VgpuInstance{nvmlVgpuInstance(1), nvmlVgpuInstance(2)},
+			},
+			instance:    nvmlVgpuInstance(3),
+			expectedRet: ERROR_NOT_FOUND,
+		},
+		{
+			description: "device error is returned",
+			device:      vgpuUtilizationDevice{ret: ERROR_NOT_SUPPORTED},
+			instance:    nvmlVgpuInstance(1),
+			expectedRet: ERROR_NOT_SUPPORTED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			_, sample, ret := vgpuInstanceUtilization(tc.device, tc.instance, 0)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expectedSample, sample)
+		})
+	}
+}
+
+func TestVgpuInstanceGetUtilizationFindsParentDevice(t *testing.T) {
+	// Device 0 does not support vGPUs; instances 7 and 8 run on device 1.
+	var handles [2]byte
+	handle := func(index int) nvmlDevice {
+		return nvmlDevice{Handle: (*_Ctype_struct_nvmlDevice_st)(unsafe.Pointer(&handles[index]))}
+	}
+	active := map[nvmlDevice][]nvmlVgpuInstance{
+		handle(1): {7, 8},
+	}
+	samples := map[nvmlDevice][]VgpuInstanceUtilizationSample{
+		handle(1): {
+			{VgpuInstance: 7, TimeStamp: 10, SmUtil: [8]byte{10}},
+			{VgpuInstance: 7, TimeStamp: 20, SmUtil: [8]byte{20}},
+		},
+	}
+
+	getCount := nvmlDeviceGetCount
+	getHandleByIndex := nvmlDeviceGetHandleByIndex
+	getActiveVgpus := nvmlDeviceGetActiveVgpusStub
+	getVgpuUtilization := nvmlDeviceGetVgpuUtilizationStub
+	defer func() {
+		nvmlDeviceGetCount = getCount
+		nvmlDeviceGetHandleByIndex = getHandleByIndex
+		nvmlDeviceGetActiveVgpusStub = getActiveVgpus
+		nvmlDeviceGetVgpuUtilizationStub = getVgpuUtilization
+	}()
+
+	nvmlDeviceGetCount = func(deviceCount *uint32) Return {
+		*deviceCount = uint32(len(handles))
+		return SUCCESS
+	}
+	nvmlDeviceGetHandleByIndex = func(index uint32, device *nvmlDevice) Return {
+		*device = handle(int(index))
+		return SUCCESS
+	}
+	nvmlDeviceGetActiveVgpusStub = func(device nvmlDevice, vgpuCount *uint32, vgpuInstances *nvmlVgpuInstance) Return {
+		instances, exists := active[device]
+		if !exists {
+			return ERROR_NOT_SUPPORTED
+		}
+		if int(*vgpuCount) < len(instances) {
+			*vgpuCount = uint32(len(instances))
+			return ERROR_INSUFFICIENT_SIZE
+		}
+		*vgpuCount = uint32(copy(unsafe.Slice(vgpuInstances, *vgpuCount), instances))
+		return SUCCESS
+	}
+	nvmlDeviceGetVgpuUtilizationStub = func(device nvmlDevice, lastSeenTimestamp uint64, sampleValType *ValueType, vgpuInstanceSamplesCount *uint32, utilizationSamples *VgpuInstanceUtilizationSample) Return {
+		s := samples[device]
+		if len(s) == 0 {
+			return ERROR_NOT_FOUND
+		}
+		if int(*vgpuInstanceSamplesCount) < len(s) {
+			*vgpuInstanceSamplesCount = uint32(len(s))
+			return ERROR_INSUFFICIENT_SIZE
+		}
+		*sampleValType = VALUE_TYPE_UNSIGNED_INT
+		*vgpuInstanceSamplesCount = uint32(copy(unsafe.Slice(utilizationSamples, *vgpuInstanceSamplesCount), s))
+		return SUCCESS
+	}
+
+	testCases := []struct {
+		description    string
+		instance       VgpuInstance
+		expectedSample VgpuInstanceUtilizationSample
+		expectedRet    Return
+	}{
+		{
+			description:    "latest sample of the instance on its parent device",
+			instance:       nvmlVgpuInstance(7),
+			expectedSample: samples[handle(1)][1],
+			expectedRet:    SUCCESS,
+		},
+		{
+			description: "idle instance returns an empty sample",
+			instance:    nvmlVgpuInstance(8),
+			expectedRet: SUCCESS,
+		},
+		{
+			description: "destroyed instance is not found",
+			instance:    nvmlVgpuInstance(9),
+			expectedRet: ERROR_NOT_FOUND,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			_, sample, ret := libnvml.VgpuInstanceGetUtilization(tc.instance, 0)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expectedSample, sample)
+		})
+	}
+}
+
+func TestGetMaxInstancesPerVm(t *testing.T) {
+	maxInstancesPerVm := map[nvmlVgpuTypeId]uint32{
+		1: 1,
+		2: 4,
+	}
+
+	original := nvmlVgpuTypeGetMaxInstancesPerVmStub
+	defer func() {
+		nvmlVgpuTypeGetMaxInstancesPerVmStub = original
+	}()
+	nvmlVgpuTypeGetMaxInstancesPerVmStub = func(vgpuTypeId nvmlVgpuTypeId, vgpuInstanceCountPerVm *uint32) Return {
+		count, exists := maxInstancesPerVm[vgpuTypeId]
+		if !exists {
+			return ERROR_INVALID_ARGUMENT
+		}
+		*vgpuInstanceCountPerVm = count
+		return SUCCESS
+	}
+
+	testCases := []struct {
+		description   string
+		vgpuTypeId    nvmlVgpuTypeId
+		expectedCount int
+		expectedRet   Return
+	}{
+		{
+			description:   "single instance per VM",
+			vgpuTypeId:    1,
+			expectedCount: 1,
+			expectedRet:   SUCCESS,
+		},
+		{
+			description:   "multiple instances per VM",
+			vgpuTypeId:    2,
+			expectedCount: 4,
+			expectedRet:   SUCCESS,
+		},
+		{
+			description: "invalid vGPU type",
+			vgpuTypeId:  3,
+			expectedRet: ERROR_INVALID_ARGUMENT,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			count, ret := libnvml.VgpuTypeGetMaxInstancesPerVm(tc.vgpuTypeId)
+			require.Equal(t, tc.expectedRet, ret)
+			require.Equal(t, tc.expectedCount, count)
+		})
+	}
+}
+
+func TestGetVgpuCompatibility(t *testing.T) {
+	original := nvmlGetVgpuCompatibilityStub
+	defer func() {
+		nvmlGetVgpuCompatibilityStub = original
+	}()
+	nvmlGetVgpuCompatibilityStub = func(vgpuMetadata *nvmlVgpuMetadata, pgpuMetadata *nvmlVgpuPgpuMetadata, compatibilityInfo *VgpuPgpuCompatibility) Return {
+		compatibilityInfo.VgpuVmCompatibility = uint32(VGPU_VM_COMPATIBILITY_NONE)
+		compatibilityInfo.CompatibilityLimitCode = uint32(VGPU_COMPATIBILITY_LIMIT_GUEST_DRIVER)
+		return SUCCESS
+	}
+
+	compatibility, ret := libnvml.GetVgpuCompatibility(&VgpuMetadata{}, &VgpuPgpuMetadata{})
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, VGPU_VM_COMPATIBILITY_NONE, VgpuVmCompatibility(compatibility.VgpuVmCompatibility))
+	require.Equal(t, VGPU_COMPATIBILITY_LIMIT_GUEST_DRIVER, VgpuPgpuCompatibilityLimitCode(compatibility.CompatibilityLimitCode))
+	require.Equal(t, "VGPU_COMPATIBILITY_LIMIT_GUEST_DRIVER", VgpuPgpuCompatibilityLimitCode(compatibility.CompatibilityLimitCode).String())
+}
+
+func TestVgpuInstanceAccounting(t *testing.T) {
+	stats := map[uint32]AccountingStats{
+		100: {GpuUtilization: 40, MaxMemoryUsage: 1 << 30, IsRunning: 1},
+		200: {GpuUtilization: 10, Time: 5000},
+		300: {},
+	}
+	pids := []uint32{100, 200, 300}
+
+	originalPids := nvmlVgpuInstanceGetAccountingPidsStub
+	originalStats := nvmlVgpuInstanceGetAccountingStatsStub
+	defer func() {
+		nvmlVgpuInstanceGetAccountingPidsStub = originalPids
+		nvmlVgpuInstanceGetAccountingStatsStub = originalStats
+	}()
+	nvmlVgpuInstanceGetAccountingPidsStub = func(vgpuInstance nvmlVgpuInstance, count *uint32, buffer *uint32) Return {
+		if *count < uint32(len(pids)) {
+			*count = uint32(len(pids))
+			return ERROR_INSUFFICIENT_SIZE
+		}
+		copy(unsafe.Slice(buffer, *count), pids)
+		*count = uint32(len(pids))
+		return SUCCESS
+	}
+	nvmlVgpuInstanceGetAccountingStatsStub = func(vgpuInstance nvmlVgpuInstance, pid uint32, s *AccountingStats) Return {
+		stat, exists := stats[pid]
+		if !exists {
+			return ERROR_NOT_FOUND
+		}
+		*s = stat
+		return SUCCESS
+	}
+
+	instance := nvmlVgpuInstance(1)
+
+	gotPids, ret := instance.GetAccountingPids()
+	require.Equal(t, SUCCESS, ret)
+	require.Equal(t, []int{100, 200, 300}, gotPids)
+
+	for _, pid := range gotPids {
+		stat, ret := libnvml.VgpuInstanceGetAccountingStats(instance, pid)
+		require.Equal(t, SUCCESS, ret)
+		require.Equal(t, stats[uint32(pid)], stat)
+	}
+
+	_, ret = instance.GetAccountingStats(400)
+	require.Equal(t, ERROR_NOT_FOUND, ret)
+}
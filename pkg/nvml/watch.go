@@ -0,0 +1,85 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml
+
+import (
+	"context"
+	"time"
+)
+
+// WatchFieldValues reads the field values with the specified ids (e.g.
+// FI_DEV_POWER_INSTANT) from a device every interval and sends the full set,
+// keyed by field id, on the returned channel. The first set is read
+// immediately. The channel is closed once ctx is done.
+//
+// Errors are reported per field through FieldValue.NvmlReturn. If the query
+// as a whole fails, every field in the set carries the returned error.
+//
+// ERROR_INVALID_ARGUMENT is returned, and nothing is watched, if ids is empty
+// or interval is not positive.
+func WatchFieldValues(ctx context.Context, d Device, ids []uint32, interval time.Duration) (<-chan map[uint32]FieldValue, Return) {
+	if len(ids) == 0 || interval <= 0 {
+		return nil, ERROR_INVALID_ARGUMENT
+	}
+
+	updates := make(chan map[uint32]FieldValue)
+
+	go func() {
+		defer close(updates)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			values, _ := readFieldValues(d, ids)
+			select {
+			case updates <- values:
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return updates, SUCCESS
+}
+
+// readFieldValues reads the field values with the specified ids from a device.
+// If the query as a whole fails, every field in the set carries the returned
+// error, which is also returned.
+func readFieldValues(d Device, ids []uint32) (map[uint32]FieldValue, Return) {
+	values := make([]FieldValue, len(ids))
+	for i, id := range ids {
+		values[i].FieldId = id
+	}
+
+	ret := d.GetFieldValues(values)
+
+	set := make(map[uint32]FieldValue, len(values))
+	for _, value := range values {
+		if ret != SUCCESS {
+			value.NvmlReturn = uint32(ret)
+		}
+		set[value.FieldId] = value
+	}
+	return set, ret
+}
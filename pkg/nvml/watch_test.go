@@ -0,0 +1,115 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nvml_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spheronFdn/nvml/pkg/nvml"
+	"github.com/spheronFdn/nvml/pkg/nvml/mock"
+)
+
+func TestWatchFieldValues(t *testing.T) {
+	reads := 0
+	device := &mock.Device{
+		GetFieldValuesFunc: func(values []nvml.FieldValue) nvml.Return {
+			reads++
+			if reads == 3 {
+				return nvml.ERROR_GPU_IS_LOST
+			}
+			for i := range values {
+				switch values[i].FieldId {
+				case nvml.FI_DEV_POWER_INSTANT:
+					values[i].ValueType = uint32(nvml.VALUE_TYPE_UNSIGNED_INT)
+					values[i].Value = [8]byte{byte(100 * reads)}
+				default:
+					values[i].NvmlReturn = uint32(nvml.ERROR_NOT_SUPPORTED)
+				}
+			}
+			return nvml.SUCCESS
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ids := []uint32{nvml.FI_DEV_POWER_INSTANT, nvml.FI_DEV_MEMORY_TEMP}
+	updates, ret := nvml.WatchFieldValues(ctx, device, ids, time.Millisecond)
+	require.Equal(t, nvml.SUCCESS, ret)
+
+	for _, expectedPower := range []uint64{100, 200} {
+		set := <-updates
+		require.Len(t, set, 2)
+
+		power, ok := set[nvml.FI_DEV_POWER_INSTANT].AsUint64()
+		require.True(t, ok)
+		require.Equal(t, expectedPower, power)
+		require.Equal(t, uint32(nvml.SUCCESS), set[nvml.FI_DEV_POWER_INSTANT].NvmlReturn)
+		require.Equal(t, uint32(nvml.ERROR_NOT_SUPPORTED), set[nvml.FI_DEV_MEMORY_TEMP].NvmlReturn)
+	}
+
+	set := <-updates
+	for _, id := range ids {
+		require.Equal(t, id, set[id].FieldId)
+		require.Equal(t, uint32(nvml.ERROR_GPU_IS_LOST), set[id].NvmlReturn)
+	}
+
+	cancel()
+	for range updates {
+	}
+}
+
+func TestWatchFieldValuesInvalidInput(t *testing.T) {
+	device := &mock.Device{
+		GetFieldValuesFunc: func(values []nvml.FieldValue) nvml.Return {
+			return nvml.SUCCESS
+		},
+	}
+
+	testCases := []struct {
+		description string
+		ids         []uint32
+		interval    time.Duration
+	}{
+		{
+			description: "no field ids",
+			interval:    time.Millisecond,
+		},
+		{
+			description: "zero interval",
+			ids:         []uint32{nvml.FI_DEV_POWER_INSTANT},
+		},
+		{
+			description: "negative interval",
+			ids:         []uint32{nvml.FI_DEV_POWER_INSTANT},
+			interval:    -time.Second,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			updates, ret := nvml.WatchFieldValues(context.Background(), device, tc.ids, tc.interval)
+			require.Equal(t, nvml.ERROR_INVALID_ARGUMENT, ret)
+			require.Nil(t, updates)
+		})
+	}
+	require.Empty(t, device.GetFieldValuesCalls())
+}
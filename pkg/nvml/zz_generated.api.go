@@ -294,9 +294,11 @@ var (
 	SystemGetDriverVersion                          = libnvml.SystemGetDriverVersion
 	SystemGetHicVersion                             = libnvml.SystemGetHicVersion
 	SystemGetNVMLVersion                            = libnvml.SystemGetNVMLVersion
+	SystemGetNvlinkBwMode                           = libnvml.SystemGetNvlinkBwMode
 	SystemGetProcessName                            = libnvml.SystemGetProcessName
 	SystemGetTopologyGpuSet                         = libnvml.SystemGetTopologyGpuSet
 	SystemSetConfComputeKeyRotationThresholdInfo    = libnvml.SystemSetConfComputeKeyRotationThresholdInfo
+	SystemSetNvlinkBwMode                           = libnvml.SystemSetNvlinkBwMode
 	UnitGetCount                                    = libnvml.UnitGetCount
 	UnitGetDevices                                  = libnvml.UnitGetDevices
 	UnitGetFanSpeedInfo                             = libnvml.UnitGetFanSpeedInfo
@@ -326,6 +328,7 @@ var (
 	VgpuInstanceGetMetadata                         = libnvml.VgpuInstanceGetMetadata
 	VgpuInstanceGetType                             = libnvml.VgpuInstanceGetType
 	VgpuInstanceGetUUID                             = libnvml.VgpuInstanceGetUUID
+	VgpuInstanceGetUtilization                      = libnvml.VgpuInstanceGetUtilization
 	VgpuInstanceGetVmDriverVersion                  = libnvml.VgpuInstanceGetVmDriverVersion
 	VgpuInstanceGetVmID                             = libnvml.VgpuInstanceGetVmID
 	VgpuInstanceSetEncoderCapacity                  = libnvml.VgpuInstanceSetEncoderCapacity
@@ -495,7 +498,7 @@ type Interface interface {
 	DeviceGetPowerState(Device) (Pstates, Return)
 	DeviceGetPowerUsage(Device) (uint32, Return)
 	DeviceGetProcessUtilization(Device, uint64) ([]ProcessUtilizationSample, Return)
-	DeviceGetProcessesUtilizationInfo(Device) (ProcessesUtilizationInfo, Return)
+	DeviceGetProcessesUtilizationInfo(Device, uint64) (ProcessesUtilizationInfo, []ProcessUtilizationInfo_v1, Return)
 	DeviceGetRemappedRows(Device) (int, int, bool, bool, Return)
 	DeviceGetRetiredPages(Device, PageRetirementCause) ([]uint64, Return)
 	DeviceGetRetiredPagesPendingStatus(Device) (EnableState, Return)
@@ -508,8 +511,8 @@ type Interface interface {
 	DeviceGetSupportedClocksEventReasons(Device) (uint64, Return)
 	DeviceGetSupportedClocksThrottleReasons(Device) (uint64, Return)
 	DeviceGetSupportedEventTypes(Device) (uint64, Return)
-	DeviceGetSupportedGraphicsClocks(Device, int) (int, uint32, Return)
-	DeviceGetSupportedMemoryClocks(Device) (int, uint32, Return)
+	DeviceGetSupportedGraphicsClocks(Device, int) (int, []uint32, Return)
+	DeviceGetSupportedMemoryClocks(Device) (int, []uint32, Return)
 	DeviceGetSupportedPerformanceStates(Device) ([]Pstates, Return)
 	DeviceGetSupportedVgpus(Device) ([]VgpuTypeId, Return)
 	DeviceGetTargetFanSpeed(Device, int) (int, Return)
@@ -621,9 +624,11 @@ type Interface interface {
 	SystemGetDriverVersion() (string, Return)
 	SystemGetHicVersion() ([]HwbcEntry, Return)
 	SystemGetNVMLVersion() (string, Return)
+	SystemGetNvlinkBwMode() (uint32, Return)
 	SystemGetProcessName(int) (string, Return)
 	SystemGetTopologyGpuSet(int) ([]Device, Return)
 	SystemSetConfComputeKeyRotationThresholdInfo(ConfComputeSetKeyRotationThresholdInfo) Return
+	SystemSetNvlinkBwMode(uint32) Return
 	UnitGetCount() (int, Return)
 	UnitGetDevices(Unit) ([]Device, Return)
 	UnitGetFanSpeedInfo(Unit) (UnitFanSpeeds, Return)
@@ -653,6 +658,7 @@ type Interface interface {
 	VgpuInstanceGetMetadata(VgpuInstance) (VgpuMetadata, Return)
 	VgpuInstanceGetType(VgpuInstance) (VgpuTypeId, Return)
 	VgpuInstanceGetUUID(VgpuInstance) (string, Return)
+	VgpuInstanceGetUtilization(VgpuInstance, uint64) (ValueType, VgpuInstanceUtilizationSample, Return)
 	VgpuInstanceGetVmDriverVersion(VgpuInstance) (string, Return)
 	VgpuInstanceGetVmID(VgpuInstance) (string, VgpuVmIdType, Return)
 	VgpuInstanceSetEncoderCapacity(VgpuInstance, int) Return
@@ -814,7 +820,7 @@ type Device interface {
 	GetPowerState() (Pstates, Return)
 	GetPowerUsage() (uint32, Return)
 	GetProcessUtilization(uint64) ([]ProcessUtilizationSample, Return)
-	GetProcessesUtilizationInfo() (ProcessesUtilizationInfo, Return)
+	GetProcessesUtilizationInfo(uint64) (ProcessesUtilizationInfo, []ProcessUtilizationInfo_v1, Return)
 	GetRemappedRows() (int, int, bool, bool, Return)
 	GetRetiredPages(PageRetirementCause) ([]uint64, Return)
 	GetRetiredPagesPendingStatus() (EnableState, Return)
@@ -827,8 +833,8 @@ type Device interface {
 	GetSupportedClocksEventReasons() (uint64, Return)
 	GetSupportedClocksThrottleReasons() (uint64, Return)
 	GetSupportedEventTypes() (uint64, Return)
-	GetSupportedGraphicsClocks(int) (int, uint32, Return)
-	GetSupportedMemoryClocks() (int, uint32, Return)
+	GetSupportedGraphicsClocks(int) (int, []uint32, Return)
+	GetSupportedMemoryClocks() (int, []uint32, Return)
 	GetSupportedPerformanceStates() ([]Pstates, Return)
 	GetSupportedVgpus() ([]VgpuTypeId, Return)
 	GetTargetFanSpeed(int) (int, Return)
@@ -981,6 +987,7 @@ type VgpuInstance interface {
 	GetMetadata() (VgpuMetadata, Return)
 	GetType() (VgpuTypeId, Return)
 	GetUUID() (string, Return)
+	GetUtilization(uint64) (ValueType, VgpuInstanceUtilizationSample, Return)
 	GetVmDriverVersion() (string, Return)
 	GetVmID() (string, VgpuVmIdType, Return)
 	SetEncoderCapacity(int) Return
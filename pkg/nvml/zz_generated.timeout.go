@@ -0,0 +1,4809 @@
+/**
+# Copyright 2024 NVIDIA CORPORATION
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Generated Code; DO NOT EDIT.
+
+package nvml
+
+import (
+	"time"
+)
+
+// timeoutDevice decorates a Device with a timeout for each call.
+type timeoutDevice struct {
+	Device
+	timeout time.Duration
+}
+
+func (t timeoutDevice) unwrapTimeout() Device {
+	return t.Device
+}
+
+func wrapDeviceWithTimeout(handle Device, timeout time.Duration) Device {
+	if handle == nil {
+		return nil
+	}
+	if _, ok := handle.(timeoutDevice); ok {
+		return handle
+	}
+	return timeoutDevice{Device: handle, timeout: timeout}
+}
+
+func wrapDevicesWithTimeout(handles []Device, timeout time.Duration) []Device {
+	if handles == nil {
+		return nil
+	}
+	wrapped := make([]Device, len(handles))
+	for i, handle := range handles {
+		wrapped[i] = wrapDeviceWithTimeout(handle, timeout)
+	}
+	return wrapped
+}
+
+// timeoutGpuInstance decorates a GpuInstance with a timeout for each call.
+type timeoutGpuInstance struct {
+	GpuInstance
+	timeout time.Duration
+}
+
+func (t timeoutGpuInstance) unwrapTimeout() GpuInstance {
+	return t.GpuInstance
+}
+
+func wrapGpuInstanceWithTimeout(handle GpuInstance, timeout time.Duration) GpuInstance {
+	if handle == nil {
+		return nil
+	}
+	if _, ok := handle.(timeoutGpuInstance); ok {
+		return handle
+	}
+	return timeoutGpuInstance{GpuInstance: handle, timeout: timeout}
+}
+
+func wrapGpuInstancesWithTimeout(handles []GpuInstance, timeout time.Duration) []GpuInstance {
+	if handles == nil {
+		return nil
+	}
+	wrapped := make([]GpuInstance, len(handles))
+	for i, handle := range handles {
+		wrapped[i] = wrapGpuInstanceWithTimeout(handle, timeout)
+	}
+	return wrapped
+}
+
+// timeoutComputeInstance decorates a ComputeInstance with a timeout for each call.
+type timeoutComputeInstance struct {
+	ComputeInstance
+	timeout time.Duration
+}
+
+func (t timeoutComputeInstance) unwrapTimeout() ComputeInstance {
+	return t.ComputeInstance
+}
+
+func wrapComputeInstanceWithTimeout(handle ComputeInstance, timeout time.Duration) ComputeInstance {
+	if handle == nil {
+		return nil
+	}
+	if _, ok := handle.(timeoutComputeInstance); ok {
+		return handle
+	}
+	return timeoutComputeInstance{ComputeInstance: handle, timeout: timeout}
+}
+
+func wrapComputeInstancesWithTimeout(handles []ComputeInstance, timeout time.Duration) []ComputeInstance {
+	if handles == nil {
+		return nil
+	}
+	wrapped := make([]ComputeInstance, len(handles))
+	for i, handle := range handles {
+		wrapped[i] = wrapComputeInstanceWithTimeout(handle, timeout)
+	}
+	return wrapped
+}
+
+// timeoutEventSet decorates a EventSet with a timeout for each call.
+type timeoutEventSet struct {
+	EventSet
+	timeout time.Duration
+}
+
+func (t timeoutEventSet) unwrapTimeout() EventSet {
+	return t.EventSet
+}
+
+func wrapEventSetWithTimeout(handle EventSet, timeout time.Duration) EventSet {
+	if handle == nil {
+		return nil
+	}
+	if _, ok := handle.(timeoutEventSet); ok {
+		return handle
+	}
+	return timeoutEventSet{EventSet: handle, timeout: timeout}
+}
+
+// timeoutGpmSample decorates a GpmSample with a timeout for each call.
+type timeoutGpmSample struct {
+	GpmSample
+	timeout time.Duration
+}
+
+func (t timeoutGpmSample) unwrapTimeout() GpmSample {
+	return t.GpmSample
+}
+
+func wrapGpmSampleWithTimeout(handle GpmSample, timeout time.Duration) GpmSample {
+	if handle == nil {
+		return nil
+	}
+	if _, ok := handle.(timeoutGpmSample); ok {
+		return handle
+	}
+	return timeoutGpmSample{GpmSample: handle, timeout: timeout}
+}
+
+// timeoutUnit decorates a Unit with a timeout for each call.
+type timeoutUnit struct {
+	Unit
+	timeout time.Duration
+}
+
+func (t timeoutUnit) unwrapTimeout() Unit {
+	return t.Unit
+}
+
+func wrapUnitWithTimeout(handle Unit, timeout time.Duration) Unit {
+	if handle == nil {
+		return nil
+	}
+	if _, ok := handle.(timeoutUnit); ok {
+		return handle
+	}
+	return timeoutUnit{Unit: handle, timeout: timeout}
+}
+
+// timeoutVgpuInstance decorates a VgpuInstance with a timeout for each call.
+type timeoutVgpuInstance struct {
+	VgpuInstance
+	timeout time.Duration
+}
+
+func (t timeoutVgpuInstance) unwrapTimeout() VgpuInstance {
+	return t.VgpuInstance
+}
+
+func wrapVgpuInstanceWithTimeout(handle VgpuInstance, timeout time.Duration) VgpuInstance {
+	if handle == nil {
+		return nil
+	}
+	if _, ok := handle.(timeoutVgpuInstance); ok {
+		return handle
+	}
+	return timeoutVgpuInstance{VgpuInstance: handle, timeout: timeout}
+}
+
+func wrapVgpuInstancesWithTimeout(handles []VgpuInstance, timeout time.Duration) []VgpuInstance {
+	if handles == nil {
+		return nil
+	}
+	wrapped := make([]VgpuInstance, len(handles))
+	for i, handle := range handles {
+		wrapped[i] = wrapVgpuInstanceWithTimeout(handle, timeout)
+	}
+	return wrapped
+}
+
+// timeoutVgpuTypeId decorates a VgpuTypeId with a timeout for each call.
+type timeoutVgpuTypeId struct {
+	VgpuTypeId
+	timeout time.Duration
+}
+
+func (t timeoutVgpuTypeId) unwrapTimeout() VgpuTypeId {
+	return t.VgpuTypeId
+}
+
+func wrapVgpuTypeIdWithTimeout(handle VgpuTypeId, timeout time.Duration) VgpuTypeId {
+	if handle == nil {
+		return nil
+	}
+	if _, ok := handle.(timeoutVgpuTypeId); ok {
+		return handle
+	}
+	return timeoutVgpuTypeId{VgpuTypeId: handle, timeout: timeout}
+}
+
+func wrapVgpuTypeIdsWithTimeout(handles []VgpuTypeId, timeout time.Duration) []VgpuTypeId {
+	if handles == nil {
+		return nil
+	}
+	wrapped := make([]VgpuTypeId, len(handles))
+	for i, handle := range handles {
+		wrapped[i] = wrapVgpuTypeIdWithTimeout(handle, timeout)
+	}
+	return wrapped
+}
+
+func (t timeoutInterface) ComputeInstanceDestroy(p0 ComputeInstance) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.ComputeInstanceDestroy(unwrapTimeout(p0))
+	})
+}
+
+func (t timeoutInterface) ComputeInstanceGetInfo(p0 ComputeInstance) (ComputeInstanceInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ComputeInstanceInfo, Return) {
+		return t.Interface.ComputeInstanceGetInfo(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceClearAccountingPids(p0 Device) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceClearAccountingPids(unwrapTimeout(p0))
+	})
+}
+
+func (t timeoutInterface) DeviceClearCpuAffinity(p0 Device) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceClearCpuAffinity(unwrapTimeout(p0))
+	})
+}
+
+func (t timeoutInterface) DeviceClearEccErrorCounts(p0 Device, p1 EccCounterType) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceClearEccErrorCounts(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceClearFieldValues(p0 Device, p1 []FieldValue) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceClearFieldValues(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceCreateGpuInstance(p0 Device, p1 *GpuInstanceProfileInfo) (GpuInstance, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuInstance, Return) {
+		return t.Interface.DeviceCreateGpuInstance(unwrapTimeout(p0), p1)
+	})
+	return wrapGpuInstanceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) DeviceCreateGpuInstanceWithPlacement(p0 Device, p1 *GpuInstanceProfileInfo, p2 *GpuInstancePlacement) (GpuInstance, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuInstance, Return) {
+		return t.Interface.DeviceCreateGpuInstanceWithPlacement(unwrapTimeout(p0), p1, p2)
+	})
+	return wrapGpuInstanceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) DeviceDiscoverGpus() (PciInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (PciInfo, Return) {
+		return t.Interface.DeviceDiscoverGpus()
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceFreezeNvLinkUtilizationCounter(p0 Device, p1 int, p2 int, p3 EnableState) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceFreezeNvLinkUtilizationCounter(unwrapTimeout(p0), p1, p2, p3)
+	})
+}
+
+func (t timeoutInterface) DeviceGetAPIRestriction(p0 Device, p1 RestrictedAPI) (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Interface.DeviceGetAPIRestriction(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetAccountingBufferSize(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetAccountingBufferSize(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetAccountingMode(p0 Device) (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Interface.DeviceGetAccountingMode(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetAccountingPids(p0 Device) ([]int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]int, Return) {
+		return t.Interface.DeviceGetAccountingPids(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetAccountingStats(p0 Device, p1 uint32) (AccountingStats, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (AccountingStats, Return) {
+		return t.Interface.DeviceGetAccountingStats(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetActiveVgpus(p0 Device) ([]VgpuInstance, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]VgpuInstance, Return) {
+		return t.Interface.DeviceGetActiveVgpus(unwrapTimeout(p0))
+	})
+	return wrapVgpuInstancesWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) DeviceGetAdaptiveClockInfoStatus(p0 Device) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetAdaptiveClockInfoStatus(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetApplicationsClock(p0 Device, p1 ClockType) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetApplicationsClock(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetArchitecture(p0 Device) (DeviceArchitecture, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (DeviceArchitecture, Return) {
+		return t.Interface.DeviceGetArchitecture(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetAttributes(p0 Device) (DeviceAttributes, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (DeviceAttributes, Return) {
+		return t.Interface.DeviceGetAttributes(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetAutoBoostedClocksEnabled(p0 Device) (EnableState, EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 EnableState
+		r1 EnableState
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetAutoBoostedClocksEnabled(unwrapTimeout(p0))
+		return struct {
+			r0 EnableState
+			r1 EnableState
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetBAR1MemoryInfo(p0 Device) (BAR1Memory, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (BAR1Memory, Return) {
+		return t.Interface.DeviceGetBAR1MemoryInfo(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetBoardId(p0 Device) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetBoardId(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetBoardPartNumber(p0 Device) (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.DeviceGetBoardPartNumber(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetBrand(p0 Device) (BrandType, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (BrandType, Return) {
+		return t.Interface.DeviceGetBrand(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetBridgeChipInfo(p0 Device) (BridgeChipHierarchy, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (BridgeChipHierarchy, Return) {
+		return t.Interface.DeviceGetBridgeChipInfo(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetBusType(p0 Device) (BusType, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (BusType, Return) {
+		return t.Interface.DeviceGetBusType(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetClkMonStatus(p0 Device) (ClkMonStatus, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ClkMonStatus, Return) {
+		return t.Interface.DeviceGetClkMonStatus(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetClock(p0 Device, p1 ClockType, p2 ClockId) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetClock(unwrapTimeout(p0), p1, p2)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetClockInfo(p0 Device, p1 ClockType) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetClockInfo(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetComputeInstanceId(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetComputeInstanceId(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetComputeMode(p0 Device) (ComputeMode, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ComputeMode, Return) {
+		return t.Interface.DeviceGetComputeMode(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetComputeRunningProcesses(p0 Device) ([]ProcessInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]ProcessInfo, Return) {
+		return t.Interface.DeviceGetComputeRunningProcesses(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetConfComputeGpuAttestationReport(p0 Device) (ConfComputeGpuAttestationReport, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ConfComputeGpuAttestationReport, Return) {
+		return t.Interface.DeviceGetConfComputeGpuAttestationReport(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetConfComputeGpuCertificate(p0 Device) (ConfComputeGpuCertificate, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ConfComputeGpuCertificate, Return) {
+		return t.Interface.DeviceGetConfComputeGpuCertificate(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetConfComputeMemSizeInfo(p0 Device) (ConfComputeMemSizeInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ConfComputeMemSizeInfo, Return) {
+		return t.Interface.DeviceGetConfComputeMemSizeInfo(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetConfComputeProtectedMemoryUsage(p0 Device) (Memory, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Memory, Return) {
+		return t.Interface.DeviceGetConfComputeProtectedMemoryUsage(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetCount() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetCount()
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetCpuAffinity(p0 Device, p1 int) ([]uint, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]uint, Return) {
+		return t.Interface.DeviceGetCpuAffinity(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetCpuAffinityWithinScope(p0 Device, p1 int, p2 AffinityScope) ([]uint, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]uint, Return) {
+		return t.Interface.DeviceGetCpuAffinityWithinScope(unwrapTimeout(p0), p1, p2)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetCreatableVgpus(p0 Device) ([]VgpuTypeId, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]VgpuTypeId, Return) {
+		return t.Interface.DeviceGetCreatableVgpus(unwrapTimeout(p0))
+	})
+	return wrapVgpuTypeIdsWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) DeviceGetCudaComputeCapability(p0 Device) (int, int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 int
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetCudaComputeCapability(unwrapTimeout(p0))
+		return struct {
+			r0 int
+			r1 int
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetCurrPcieLinkGeneration(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetCurrPcieLinkGeneration(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetCurrPcieLinkWidth(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetCurrPcieLinkWidth(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetCurrentClocksEventReasons(p0 Device) (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Interface.DeviceGetCurrentClocksEventReasons(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetCurrentClocksThrottleReasons(p0 Device) (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Interface.DeviceGetCurrentClocksThrottleReasons(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetDecoderUtilization(p0 Device) (uint32, uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint32
+		r1 uint32
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetDecoderUtilization(unwrapTimeout(p0))
+		return struct {
+			r0 uint32
+			r1 uint32
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetDefaultApplicationsClock(p0 Device, p1 ClockType) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetDefaultApplicationsClock(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetDefaultEccMode(p0 Device) (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Interface.DeviceGetDefaultEccMode(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetDetailedEccErrors(p0 Device, p1 MemoryErrorType, p2 EccCounterType) (EccErrorCounts, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EccErrorCounts, Return) {
+		return t.Interface.DeviceGetDetailedEccErrors(unwrapTimeout(p0), p1, p2)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetDeviceHandleFromMigDeviceHandle(p0 Device) (Device, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Device, Return) {
+		return t.Interface.DeviceGetDeviceHandleFromMigDeviceHandle(unwrapTimeout(p0))
+	})
+	return wrapDeviceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) DeviceGetDisplayActive(p0 Device) (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Interface.DeviceGetDisplayActive(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetDisplayMode(p0 Device) (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Interface.DeviceGetDisplayMode(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetDriverModel(p0 Device) (DriverModel, DriverModel, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 DriverModel
+		r1 DriverModel
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetDriverModel(unwrapTimeout(p0))
+		return struct {
+			r0 DriverModel
+			r1 DriverModel
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetDynamicPstatesInfo(p0 Device) (GpuDynamicPstatesInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuDynamicPstatesInfo, Return) {
+		return t.Interface.DeviceGetDynamicPstatesInfo(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetEccMode(p0 Device) (EnableState, EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 EnableState
+		r1 EnableState
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetEccMode(unwrapTimeout(p0))
+		return struct {
+			r0 EnableState
+			r1 EnableState
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetEncoderCapacity(p0 Device, p1 EncoderType) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetEncoderCapacity(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetEncoderSessions(p0 Device) ([]EncoderSessionInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]EncoderSessionInfo, Return) {
+		return t.Interface.DeviceGetEncoderSessions(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetEncoderStats(p0 Device) (int, uint32, uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 uint32
+		r2 uint32
+	}, Return) {
+		r0, r1, r2, ret := t.Interface.DeviceGetEncoderStats(unwrapTimeout(p0))
+		return struct {
+			r0 int
+			r1 uint32
+			r2 uint32
+		}{r0, r1, r2}, ret
+	})
+	return r.r0, r.r1, r.r2, ret
+}
+
+func (t timeoutInterface) DeviceGetEncoderUtilization(p0 Device) (uint32, uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint32
+		r1 uint32
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetEncoderUtilization(unwrapTimeout(p0))
+		return struct {
+			r0 uint32
+			r1 uint32
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetEnforcedPowerLimit(p0 Device) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetEnforcedPowerLimit(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetFBCSessions(p0 Device) ([]FBCSessionInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]FBCSessionInfo, Return) {
+		return t.Interface.DeviceGetFBCSessions(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetFBCStats(p0 Device) (FBCStats, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (FBCStats, Return) {
+		return t.Interface.DeviceGetFBCStats(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetFanControlPolicy_v2(p0 Device, p1 int) (FanControlPolicy, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (FanControlPolicy, Return) {
+		return t.Interface.DeviceGetFanControlPolicy_v2(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetFanSpeed(p0 Device) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetFanSpeed(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetFanSpeed_v2(p0 Device, p1 int) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetFanSpeed_v2(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetFieldValues(p0 Device, p1 []FieldValue) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceGetFieldValues(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceGetGpcClkMinMaxVfOffset(p0 Device) (int, int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 int
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetGpcClkMinMaxVfOffset(unwrapTimeout(p0))
+		return struct {
+			r0 int
+			r1 int
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetGpcClkVfOffset(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetGpcClkVfOffset(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetGpuFabricInfo(p0 Device) (GpuFabricInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuFabricInfo, Return) {
+		return t.Interface.DeviceGetGpuFabricInfo(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetGpuInstanceById(p0 Device, p1 int) (GpuInstance, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuInstance, Return) {
+		return t.Interface.DeviceGetGpuInstanceById(unwrapTimeout(p0), p1)
+	})
+	return wrapGpuInstanceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) DeviceGetGpuInstanceId(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetGpuInstanceId(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetGpuInstancePossiblePlacements(p0 Device, p1 *GpuInstanceProfileInfo) ([]GpuInstancePlacement, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]GpuInstancePlacement, Return) {
+		return t.Interface.DeviceGetGpuInstancePossiblePlacements(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetGpuInstanceProfileInfo(p0 Device, p1 int) (GpuInstanceProfileInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuInstanceProfileInfo, Return) {
+		return t.Interface.DeviceGetGpuInstanceProfileInfo(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetGpuInstanceRemainingCapacity(p0 Device, p1 *GpuInstanceProfileInfo) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetGpuInstanceRemainingCapacity(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetGpuInstances(p0 Device, p1 *GpuInstanceProfileInfo) ([]GpuInstance, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]GpuInstance, Return) {
+		return t.Interface.DeviceGetGpuInstances(unwrapTimeout(p0), p1)
+	})
+	return wrapGpuInstancesWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) DeviceGetGpuMaxPcieLinkGeneration(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetGpuMaxPcieLinkGeneration(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetGpuOperationMode(p0 Device) (GpuOperationMode, GpuOperationMode, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 GpuOperationMode
+		r1 GpuOperationMode
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetGpuOperationMode(unwrapTimeout(p0))
+		return struct {
+			r0 GpuOperationMode
+			r1 GpuOperationMode
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetGraphicsRunningProcesses(p0 Device) ([]ProcessInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]ProcessInfo, Return) {
+		return t.Interface.DeviceGetGraphicsRunningProcesses(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetGridLicensableFeatures(p0 Device) (GridLicensableFeatures, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GridLicensableFeatures, Return) {
+		return t.Interface.DeviceGetGridLicensableFeatures(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetGspFirmwareMode(p0 Device) (bool, bool, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 bool
+		r1 bool
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetGspFirmwareMode(unwrapTimeout(p0))
+		return struct {
+			r0 bool
+			r1 bool
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetGspFirmwareVersion(p0 Device) (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.DeviceGetGspFirmwareVersion(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetHandleByIndex(p0 int) (Device, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Device, Return) {
+		return t.Interface.DeviceGetHandleByIndex(p0)
+	})
+	return wrapDeviceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) DeviceGetHandleByPciBusId(p0 string) (Device, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Device, Return) {
+		return t.Interface.DeviceGetHandleByPciBusId(p0)
+	})
+	return wrapDeviceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) DeviceGetHandleBySerial(p0 string) (Device, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Device, Return) {
+		return t.Interface.DeviceGetHandleBySerial(p0)
+	})
+	return wrapDeviceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) DeviceGetHandleByUUID(p0 string) (Device, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Device, Return) {
+		return t.Interface.DeviceGetHandleByUUID(p0)
+	})
+	return wrapDeviceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) DeviceGetHostVgpuMode(p0 Device) (HostVgpuMode, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (HostVgpuMode, Return) {
+		return t.Interface.DeviceGetHostVgpuMode(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetIndex(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetIndex(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetInforomConfigurationChecksum(p0 Device) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetInforomConfigurationChecksum(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetInforomImageVersion(p0 Device) (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.DeviceGetInforomImageVersion(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetInforomVersion(p0 Device, p1 InforomObject) (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.DeviceGetInforomVersion(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetIrqNum(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetIrqNum(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetJpgUtilization(p0 Device) (uint32, uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint32
+		r1 uint32
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetJpgUtilization(unwrapTimeout(p0))
+		return struct {
+			r0 uint32
+			r1 uint32
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetLastBBXFlushTime(p0 Device) (uint64, uint, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint64
+		r1 uint
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetLastBBXFlushTime(unwrapTimeout(p0))
+		return struct {
+			r0 uint64
+			r1 uint
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetMPSComputeRunningProcesses(p0 Device) ([]ProcessInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]ProcessInfo, Return) {
+		return t.Interface.DeviceGetMPSComputeRunningProcesses(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetMaxClockInfo(p0 Device, p1 ClockType) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetMaxClockInfo(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetMaxCustomerBoostClock(p0 Device, p1 ClockType) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetMaxCustomerBoostClock(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetMaxMigDeviceCount(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetMaxMigDeviceCount(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetMaxPcieLinkGeneration(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetMaxPcieLinkGeneration(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetMaxPcieLinkWidth(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetMaxPcieLinkWidth(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetMemClkMinMaxVfOffset(p0 Device) (int, int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 int
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetMemClkMinMaxVfOffset(unwrapTimeout(p0))
+		return struct {
+			r0 int
+			r1 int
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetMemClkVfOffset(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetMemClkVfOffset(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetMemoryAffinity(p0 Device, p1 int, p2 AffinityScope) ([]uint, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]uint, Return) {
+		return t.Interface.DeviceGetMemoryAffinity(unwrapTimeout(p0), p1, p2)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetMemoryBusWidth(p0 Device) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetMemoryBusWidth(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetMemoryErrorCounter(p0 Device, p1 MemoryErrorType, p2 EccCounterType, p3 MemoryLocation) (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Interface.DeviceGetMemoryErrorCounter(unwrapTimeout(p0), p1, p2, p3)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetMemoryInfo(p0 Device) (Memory, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Memory, Return) {
+		return t.Interface.DeviceGetMemoryInfo(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetMemoryInfo_v2(p0 Device) (Memory_v2, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Memory_v2, Return) {
+		return t.Interface.DeviceGetMemoryInfo_v2(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetMigDeviceHandleByIndex(p0 Device, p1 int) (Device, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Device, Return) {
+		return t.Interface.DeviceGetMigDeviceHandleByIndex(unwrapTimeout(p0), p1)
+	})
+	return wrapDeviceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) DeviceGetMigMode(p0 Device) (int, int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 int
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetMigMode(unwrapTimeout(p0))
+		return struct {
+			r0 int
+			r1 int
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetMinMaxClockOfPState(p0 Device, p1 ClockType, p2 Pstates) (uint32, uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint32
+		r1 uint32
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetMinMaxClockOfPState(unwrapTimeout(p0), p1, p2)
+		return struct {
+			r0 uint32
+			r1 uint32
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetMinMaxFanSpeed(p0 Device) (int, int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 int
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetMinMaxFanSpeed(unwrapTimeout(p0))
+		return struct {
+			r0 int
+			r1 int
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetMinorNumber(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetMinorNumber(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetModuleId(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetModuleId(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetMultiGpuBoard(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetMultiGpuBoard(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetName(p0 Device) (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.DeviceGetName(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetNumFans(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetNumFans(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetNumGpuCores(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetNumGpuCores(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetNumaNodeId(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetNumaNodeId(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetNvLinkCapability(p0 Device, p1 int, p2 NvLinkCapability) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetNvLinkCapability(unwrapTimeout(p0), p1, p2)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetNvLinkErrorCounter(p0 Device, p1 int, p2 NvLinkErrorCounter) (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Interface.DeviceGetNvLinkErrorCounter(unwrapTimeout(p0), p1, p2)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetNvLinkRemoteDeviceType(p0 Device, p1 int) (IntNvLinkDeviceType, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (IntNvLinkDeviceType, Return) {
+		return t.Interface.DeviceGetNvLinkRemoteDeviceType(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetNvLinkRemotePciInfo(p0 Device, p1 int) (PciInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (PciInfo, Return) {
+		return t.Interface.DeviceGetNvLinkRemotePciInfo(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetNvLinkState(p0 Device, p1 int) (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Interface.DeviceGetNvLinkState(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetNvLinkUtilizationControl(p0 Device, p1 int, p2 int) (NvLinkUtilizationControl, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (NvLinkUtilizationControl, Return) {
+		return t.Interface.DeviceGetNvLinkUtilizationControl(unwrapTimeout(p0), p1, p2)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetNvLinkUtilizationCounter(p0 Device, p1 int, p2 int) (uint64, uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint64
+		r1 uint64
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetNvLinkUtilizationCounter(unwrapTimeout(p0), p1, p2)
+		return struct {
+			r0 uint64
+			r1 uint64
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetNvLinkVersion(p0 Device, p1 int) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetNvLinkVersion(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetOfaUtilization(p0 Device) (uint32, uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint32
+		r1 uint32
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetOfaUtilization(unwrapTimeout(p0))
+		return struct {
+			r0 uint32
+			r1 uint32
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetP2PStatus(p0 Device, p1 Device, p2 GpuP2PCapsIndex) (GpuP2PStatus, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuP2PStatus, Return) {
+		return t.Interface.DeviceGetP2PStatus(unwrapTimeout(p0), unwrapTimeout(p1), p2)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetPciInfo(p0 Device) (PciInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (PciInfo, Return) {
+		return t.Interface.DeviceGetPciInfo(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetPciInfoExt(p0 Device) (PciInfoExt, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (PciInfoExt, Return) {
+		return t.Interface.DeviceGetPciInfoExt(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetPcieLinkMaxSpeed(p0 Device) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetPcieLinkMaxSpeed(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetPcieReplayCounter(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetPcieReplayCounter(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetPcieSpeed(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetPcieSpeed(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetPcieThroughput(p0 Device, p1 PcieUtilCounter) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetPcieThroughput(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetPerformanceState(p0 Device) (Pstates, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Pstates, Return) {
+		return t.Interface.DeviceGetPerformanceState(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetPersistenceMode(p0 Device) (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Interface.DeviceGetPersistenceMode(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetPgpuMetadataString(p0 Device) (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.DeviceGetPgpuMetadataString(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetPowerManagementDefaultLimit(p0 Device) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetPowerManagementDefaultLimit(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetPowerManagementLimit(p0 Device) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetPowerManagementLimit(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetPowerManagementLimitConstraints(p0 Device) (uint32, uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint32
+		r1 uint32
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetPowerManagementLimitConstraints(unwrapTimeout(p0))
+		return struct {
+			r0 uint32
+			r1 uint32
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetPowerManagementMode(p0 Device) (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Interface.DeviceGetPowerManagementMode(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetPowerSource(p0 Device) (PowerSource, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (PowerSource, Return) {
+		return t.Interface.DeviceGetPowerSource(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetPowerState(p0 Device) (Pstates, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Pstates, Return) {
+		return t.Interface.DeviceGetPowerState(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetPowerUsage(p0 Device) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetPowerUsage(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetProcessUtilization(p0 Device, p1 uint64) ([]ProcessUtilizationSample, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]ProcessUtilizationSample, Return) {
+		return t.Interface.DeviceGetProcessUtilization(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetProcessesUtilizationInfo(p0 Device, p1 uint64) (ProcessesUtilizationInfo, []ProcessUtilizationInfo_v1, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 ProcessesUtilizationInfo
+		r1 []ProcessUtilizationInfo_v1
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetProcessesUtilizationInfo(unwrapTimeout(p0), p1)
+		return struct {
+			r0 ProcessesUtilizationInfo
+			r1 []ProcessUtilizationInfo_v1
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetRemappedRows(p0 Device) (int, int, bool, bool, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 int
+		r2 bool
+		r3 bool
+	}, Return) {
+		r0, r1, r2, r3, ret := t.Interface.DeviceGetRemappedRows(unwrapTimeout(p0))
+		return struct {
+			r0 int
+			r1 int
+			r2 bool
+			r3 bool
+		}{r0, r1, r2, r3}, ret
+	})
+	return r.r0, r.r1, r.r2, r.r3, ret
+}
+
+func (t timeoutInterface) DeviceGetRetiredPages(p0 Device, p1 PageRetirementCause) ([]uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]uint64, Return) {
+		return t.Interface.DeviceGetRetiredPages(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetRetiredPagesPendingStatus(p0 Device) (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Interface.DeviceGetRetiredPagesPendingStatus(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetRetiredPages_v2(p0 Device, p1 PageRetirementCause) ([]uint64, []uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 []uint64
+		r1 []uint64
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetRetiredPages_v2(unwrapTimeout(p0), p1)
+		return struct {
+			r0 []uint64
+			r1 []uint64
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetRowRemapperHistogram(p0 Device) (RowRemapperHistogramValues, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (RowRemapperHistogramValues, Return) {
+		return t.Interface.DeviceGetRowRemapperHistogram(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetRunningProcessDetailList(p0 Device) (ProcessDetailList, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ProcessDetailList, Return) {
+		return t.Interface.DeviceGetRunningProcessDetailList(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetSamples(p0 Device, p1 SamplingType, p2 uint64) (ValueType, []Sample, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 ValueType
+		r1 []Sample
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetSamples(unwrapTimeout(p0), p1, p2)
+		return struct {
+			r0 ValueType
+			r1 []Sample
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetSerial(p0 Device) (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.DeviceGetSerial(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetSramEccErrorStatus(p0 Device) (EccSramErrorStatus, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EccSramErrorStatus, Return) {
+		return t.Interface.DeviceGetSramEccErrorStatus(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetSupportedClocksEventReasons(p0 Device) (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Interface.DeviceGetSupportedClocksEventReasons(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetSupportedClocksThrottleReasons(p0 Device) (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Interface.DeviceGetSupportedClocksThrottleReasons(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetSupportedEventTypes(p0 Device) (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Interface.DeviceGetSupportedEventTypes(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetSupportedGraphicsClocks(p0 Device, p1 int) (int, []uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 []uint32
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetSupportedGraphicsClocks(unwrapTimeout(p0), p1)
+		return struct {
+			r0 int
+			r1 []uint32
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetSupportedMemoryClocks(p0 Device) (int, []uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 []uint32
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetSupportedMemoryClocks(unwrapTimeout(p0))
+		return struct {
+			r0 int
+			r1 []uint32
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetSupportedPerformanceStates(p0 Device) ([]Pstates, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]Pstates, Return) {
+		return t.Interface.DeviceGetSupportedPerformanceStates(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetSupportedVgpus(p0 Device) ([]VgpuTypeId, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]VgpuTypeId, Return) {
+		return t.Interface.DeviceGetSupportedVgpus(unwrapTimeout(p0))
+	})
+	return wrapVgpuTypeIdsWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) DeviceGetTargetFanSpeed(p0 Device, p1 int) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceGetTargetFanSpeed(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetTemperature(p0 Device, p1 TemperatureSensors) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetTemperature(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetTemperatureThreshold(p0 Device, p1 TemperatureThresholds) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.DeviceGetTemperatureThreshold(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetThermalSettings(p0 Device, p1 uint32) (GpuThermalSettings, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuThermalSettings, Return) {
+		return t.Interface.DeviceGetThermalSettings(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetTopologyCommonAncestor(p0 Device, p1 Device) (GpuTopologyLevel, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuTopologyLevel, Return) {
+		return t.Interface.DeviceGetTopologyCommonAncestor(unwrapTimeout(p0), unwrapTimeout(p1))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetTopologyNearestGpus(p0 Device, p1 GpuTopologyLevel) ([]Device, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]Device, Return) {
+		return t.Interface.DeviceGetTopologyNearestGpus(unwrapTimeout(p0), p1)
+	})
+	return wrapDevicesWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) DeviceGetTotalEccErrors(p0 Device, p1 MemoryErrorType, p2 EccCounterType) (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Interface.DeviceGetTotalEccErrors(unwrapTimeout(p0), p1, p2)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetTotalEnergyConsumption(p0 Device) (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Interface.DeviceGetTotalEnergyConsumption(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetUUID(p0 Device) (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.DeviceGetUUID(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetUtilizationRates(p0 Device) (Utilization, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Utilization, Return) {
+		return t.Interface.DeviceGetUtilizationRates(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetVbiosVersion(p0 Device) (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.DeviceGetVbiosVersion(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetVgpuCapabilities(p0 Device, p1 DeviceVgpuCapability) (bool, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (bool, Return) {
+		return t.Interface.DeviceGetVgpuCapabilities(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetVgpuHeterogeneousMode(p0 Device) (VgpuHeterogeneousMode, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuHeterogeneousMode, Return) {
+		return t.Interface.DeviceGetVgpuHeterogeneousMode(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetVgpuInstancesUtilizationInfo(p0 Device) (VgpuInstancesUtilizationInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuInstancesUtilizationInfo, Return) {
+		return t.Interface.DeviceGetVgpuInstancesUtilizationInfo(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetVgpuMetadata(p0 Device) (VgpuPgpuMetadata, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuPgpuMetadata, Return) {
+		return t.Interface.DeviceGetVgpuMetadata(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetVgpuProcessUtilization(p0 Device, p1 uint64) ([]VgpuProcessUtilizationSample, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]VgpuProcessUtilizationSample, Return) {
+		return t.Interface.DeviceGetVgpuProcessUtilization(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetVgpuProcessesUtilizationInfo(p0 Device) (VgpuProcessesUtilizationInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuProcessesUtilizationInfo, Return) {
+		return t.Interface.DeviceGetVgpuProcessesUtilizationInfo(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetVgpuSchedulerCapabilities(p0 Device) (VgpuSchedulerCapabilities, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuSchedulerCapabilities, Return) {
+		return t.Interface.DeviceGetVgpuSchedulerCapabilities(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetVgpuSchedulerLog(p0 Device) (VgpuSchedulerLog, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuSchedulerLog, Return) {
+		return t.Interface.DeviceGetVgpuSchedulerLog(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetVgpuSchedulerState(p0 Device) (VgpuSchedulerGetState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuSchedulerGetState, Return) {
+		return t.Interface.DeviceGetVgpuSchedulerState(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetVgpuTypeCreatablePlacements(p0 Device, p1 VgpuTypeId) (VgpuPlacementList, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuPlacementList, Return) {
+		return t.Interface.DeviceGetVgpuTypeCreatablePlacements(unwrapTimeout(p0), unwrapTimeout(p1))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetVgpuTypeSupportedPlacements(p0 Device, p1 VgpuTypeId) (VgpuPlacementList, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuPlacementList, Return) {
+		return t.Interface.DeviceGetVgpuTypeSupportedPlacements(unwrapTimeout(p0), unwrapTimeout(p1))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetVgpuUtilization(p0 Device, p1 uint64) (ValueType, []VgpuInstanceUtilizationSample, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 ValueType
+		r1 []VgpuInstanceUtilizationSample
+	}, Return) {
+		r0, r1, ret := t.Interface.DeviceGetVgpuUtilization(unwrapTimeout(p0), p1)
+		return struct {
+			r0 ValueType
+			r1 []VgpuInstanceUtilizationSample
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) DeviceGetViolationStatus(p0 Device, p1 PerfPolicyType) (ViolationTime, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ViolationTime, Return) {
+		return t.Interface.DeviceGetViolationStatus(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceGetVirtualizationMode(p0 Device) (GpuVirtualizationMode, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuVirtualizationMode, Return) {
+		return t.Interface.DeviceGetVirtualizationMode(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceIsMigDeviceHandle(p0 Device) (bool, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (bool, Return) {
+		return t.Interface.DeviceIsMigDeviceHandle(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceModifyDrainState(p0 *PciInfo, p1 EnableState) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceModifyDrainState(p0, p1)
+	})
+}
+
+func (t timeoutInterface) DeviceOnSameBoard(p0 Device, p1 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.DeviceOnSameBoard(unwrapTimeout(p0), unwrapTimeout(p1))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceQueryDrainState(p0 *PciInfo) (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Interface.DeviceQueryDrainState(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceRegisterEvents(p0 Device, p1 uint64, p2 EventSet) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceRegisterEvents(unwrapTimeout(p0), p1, unwrapTimeout(p2))
+	})
+}
+
+func (t timeoutInterface) DeviceRemoveGpu(p0 *PciInfo) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceRemoveGpu(p0)
+	})
+}
+
+func (t timeoutInterface) DeviceRemoveGpu_v2(p0 *PciInfo, p1 DetachGpuState, p2 PcieLinkState) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceRemoveGpu_v2(p0, p1, p2)
+	})
+}
+
+func (t timeoutInterface) DeviceResetApplicationsClocks(p0 Device) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceResetApplicationsClocks(unwrapTimeout(p0))
+	})
+}
+
+func (t timeoutInterface) DeviceResetGpuLockedClocks(p0 Device) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceResetGpuLockedClocks(unwrapTimeout(p0))
+	})
+}
+
+func (t timeoutInterface) DeviceResetMemoryLockedClocks(p0 Device) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceResetMemoryLockedClocks(unwrapTimeout(p0))
+	})
+}
+
+func (t timeoutInterface) DeviceResetNvLinkErrorCounters(p0 Device, p1 int) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceResetNvLinkErrorCounters(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceResetNvLinkUtilizationCounter(p0 Device, p1 int, p2 int) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceResetNvLinkUtilizationCounter(unwrapTimeout(p0), p1, p2)
+	})
+}
+
+func (t timeoutInterface) DeviceSetAPIRestriction(p0 Device, p1 RestrictedAPI, p2 EnableState) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetAPIRestriction(unwrapTimeout(p0), p1, p2)
+	})
+}
+
+func (t timeoutInterface) DeviceSetAccountingMode(p0 Device, p1 EnableState) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetAccountingMode(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceSetApplicationsClocks(p0 Device, p1 uint32, p2 uint32) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetApplicationsClocks(unwrapTimeout(p0), p1, p2)
+	})
+}
+
+func (t timeoutInterface) DeviceSetAutoBoostedClocksEnabled(p0 Device, p1 EnableState) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetAutoBoostedClocksEnabled(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceSetComputeMode(p0 Device, p1 ComputeMode) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetComputeMode(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceSetConfComputeUnprotectedMemSize(p0 Device, p1 uint64) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetConfComputeUnprotectedMemSize(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceSetCpuAffinity(p0 Device) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetCpuAffinity(unwrapTimeout(p0))
+	})
+}
+
+func (t timeoutInterface) DeviceSetDefaultAutoBoostedClocksEnabled(p0 Device, p1 EnableState, p2 uint32) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetDefaultAutoBoostedClocksEnabled(unwrapTimeout(p0), p1, p2)
+	})
+}
+
+func (t timeoutInterface) DeviceSetDefaultFanSpeed_v2(p0 Device, p1 int) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetDefaultFanSpeed_v2(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceSetDriverModel(p0 Device, p1 DriverModel, p2 uint32) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetDriverModel(unwrapTimeout(p0), p1, p2)
+	})
+}
+
+func (t timeoutInterface) DeviceSetEccMode(p0 Device, p1 EnableState) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetEccMode(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceSetFanControlPolicy(p0 Device, p1 int, p2 FanControlPolicy) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetFanControlPolicy(unwrapTimeout(p0), p1, p2)
+	})
+}
+
+func (t timeoutInterface) DeviceSetFanSpeed_v2(p0 Device, p1 int, p2 int) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetFanSpeed_v2(unwrapTimeout(p0), p1, p2)
+	})
+}
+
+func (t timeoutInterface) DeviceSetGpcClkVfOffset(p0 Device, p1 int) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetGpcClkVfOffset(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceSetGpuLockedClocks(p0 Device, p1 uint32, p2 uint32) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetGpuLockedClocks(unwrapTimeout(p0), p1, p2)
+	})
+}
+
+func (t timeoutInterface) DeviceSetGpuOperationMode(p0 Device, p1 GpuOperationMode) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetGpuOperationMode(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceSetMemClkVfOffset(p0 Device, p1 int) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetMemClkVfOffset(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceSetMemoryLockedClocks(p0 Device, p1 uint32, p2 uint32) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetMemoryLockedClocks(unwrapTimeout(p0), p1, p2)
+	})
+}
+
+func (t timeoutInterface) DeviceSetMigMode(p0 Device, p1 int) (Return, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Return, Return) {
+		return t.Interface.DeviceSetMigMode(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) DeviceSetNvLinkDeviceLowPowerThreshold(p0 Device, p1 *NvLinkPowerThres) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetNvLinkDeviceLowPowerThreshold(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceSetNvLinkUtilizationControl(p0 Device, p1 int, p2 int, p3 *NvLinkUtilizationControl, p4 bool) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetNvLinkUtilizationControl(unwrapTimeout(p0), p1, p2, p3, p4)
+	})
+}
+
+func (t timeoutInterface) DeviceSetPersistenceMode(p0 Device, p1 EnableState) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetPersistenceMode(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceSetPowerManagementLimit(p0 Device, p1 uint32) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetPowerManagementLimit(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceSetPowerManagementLimit_v2(p0 Device, p1 *PowerValue_v2) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetPowerManagementLimit_v2(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceSetTemperatureThreshold(p0 Device, p1 TemperatureThresholds, p2 int) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetTemperatureThreshold(unwrapTimeout(p0), p1, p2)
+	})
+}
+
+func (t timeoutInterface) DeviceSetVgpuCapabilities(p0 Device, p1 DeviceVgpuCapability, p2 EnableState) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetVgpuCapabilities(unwrapTimeout(p0), p1, p2)
+	})
+}
+
+func (t timeoutInterface) DeviceSetVgpuHeterogeneousMode(p0 Device, p1 VgpuHeterogeneousMode) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetVgpuHeterogeneousMode(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceSetVgpuSchedulerState(p0 Device, p1 *VgpuSchedulerSetState) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetVgpuSchedulerState(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceSetVirtualizationMode(p0 Device, p1 GpuVirtualizationMode) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceSetVirtualizationMode(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) DeviceValidateInforom(p0 Device) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.DeviceValidateInforom(unwrapTimeout(p0))
+	})
+}
+
+func (t timeoutInterface) EventSetCreate() (EventSet, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EventSet, Return) {
+		return t.Interface.EventSetCreate()
+	})
+	return wrapEventSetWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) EventSetFree(p0 EventSet) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.EventSetFree(unwrapTimeout(p0))
+	})
+}
+
+func (t timeoutInterface) EventSetWait(p0 EventSet, p1 uint32) (EventData, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EventData, Return) {
+		return t.Interface.EventSetWait(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) GetExcludedDeviceCount() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.GetExcludedDeviceCount()
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) GetExcludedDeviceInfoByIndex(p0 int) (ExcludedDeviceInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ExcludedDeviceInfo, Return) {
+		return t.Interface.GetExcludedDeviceInfoByIndex(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) GetVgpuCompatibility(p0 *VgpuMetadata, p1 *VgpuPgpuMetadata) (VgpuPgpuCompatibility, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuPgpuCompatibility, Return) {
+		return t.Interface.GetVgpuCompatibility(p0, p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) GetVgpuDriverCapabilities(p0 VgpuDriverCapability) (bool, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (bool, Return) {
+		return t.Interface.GetVgpuDriverCapabilities(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) GetVgpuVersion() (VgpuVersion, VgpuVersion, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 VgpuVersion
+		r1 VgpuVersion
+	}, Return) {
+		r0, r1, ret := t.Interface.GetVgpuVersion()
+		return struct {
+			r0 VgpuVersion
+			r1 VgpuVersion
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) GpmMetricsGet(p0 *GpmMetricsGetType) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.GpmMetricsGet(p0)
+	})
+}
+
+func (t timeoutInterface) GpmMigSampleGet(p0 Device, p1 int, p2 GpmSample) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.GpmMigSampleGet(unwrapTimeout(p0), p1, unwrapTimeout(p2))
+	})
+}
+
+func (t timeoutInterface) GpmQueryDeviceSupport(p0 Device) (GpmSupport, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpmSupport, Return) {
+		return t.Interface.GpmQueryDeviceSupport(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) GpmQueryIfStreamingEnabled(p0 Device) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.GpmQueryIfStreamingEnabled(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) GpmSampleAlloc() (GpmSample, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpmSample, Return) {
+		return t.Interface.GpmSampleAlloc()
+	})
+	return wrapGpmSampleWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) GpmSampleFree(p0 GpmSample) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.GpmSampleFree(unwrapTimeout(p0))
+	})
+}
+
+func (t timeoutInterface) GpmSampleGet(p0 Device, p1 GpmSample) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.GpmSampleGet(unwrapTimeout(p0), unwrapTimeout(p1))
+	})
+}
+
+func (t timeoutInterface) GpmSetStreamingEnabled(p0 Device, p1 uint32) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.GpmSetStreamingEnabled(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) GpuInstanceCreateComputeInstance(p0 GpuInstance, p1 *ComputeInstanceProfileInfo) (ComputeInstance, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ComputeInstance, Return) {
+		return t.Interface.GpuInstanceCreateComputeInstance(unwrapTimeout(p0), p1)
+	})
+	return wrapComputeInstanceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) GpuInstanceCreateComputeInstanceWithPlacement(p0 GpuInstance, p1 *ComputeInstanceProfileInfo, p2 *ComputeInstancePlacement) (ComputeInstance, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ComputeInstance, Return) {
+		return t.Interface.GpuInstanceCreateComputeInstanceWithPlacement(unwrapTimeout(p0), p1, p2)
+	})
+	return wrapComputeInstanceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) GpuInstanceDestroy(p0 GpuInstance) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.GpuInstanceDestroy(unwrapTimeout(p0))
+	})
+}
+
+func (t timeoutInterface) GpuInstanceGetComputeInstanceById(p0 GpuInstance, p1 int) (ComputeInstance, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ComputeInstance, Return) {
+		return t.Interface.GpuInstanceGetComputeInstanceById(unwrapTimeout(p0), p1)
+	})
+	return wrapComputeInstanceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) GpuInstanceGetComputeInstancePossiblePlacements(p0 GpuInstance, p1 *ComputeInstanceProfileInfo) ([]ComputeInstancePlacement, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]ComputeInstancePlacement, Return) {
+		return t.Interface.GpuInstanceGetComputeInstancePossiblePlacements(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) GpuInstanceGetComputeInstanceProfileInfo(p0 GpuInstance, p1 int, p2 int) (ComputeInstanceProfileInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ComputeInstanceProfileInfo, Return) {
+		return t.Interface.GpuInstanceGetComputeInstanceProfileInfo(unwrapTimeout(p0), p1, p2)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) GpuInstanceGetComputeInstanceRemainingCapacity(p0 GpuInstance, p1 *ComputeInstanceProfileInfo) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.GpuInstanceGetComputeInstanceRemainingCapacity(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) GpuInstanceGetComputeInstances(p0 GpuInstance, p1 *ComputeInstanceProfileInfo) ([]ComputeInstance, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]ComputeInstance, Return) {
+		return t.Interface.GpuInstanceGetComputeInstances(unwrapTimeout(p0), p1)
+	})
+	return wrapComputeInstancesWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) GpuInstanceGetInfo(p0 GpuInstance) (GpuInstanceInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuInstanceInfo, Return) {
+		return t.Interface.GpuInstanceGetInfo(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) Init() Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.Init()
+	})
+}
+
+func (t timeoutInterface) InitWithFlags(p0 uint32) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.InitWithFlags(p0)
+	})
+}
+
+func (t timeoutInterface) SetVgpuVersion(p0 *VgpuVersion) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.SetVgpuVersion(p0)
+	})
+}
+
+func (t timeoutInterface) Shutdown() Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.Shutdown()
+	})
+}
+
+func (t timeoutInterface) SystemGetConfComputeCapabilities() (ConfComputeSystemCaps, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ConfComputeSystemCaps, Return) {
+		return t.Interface.SystemGetConfComputeCapabilities()
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) SystemGetConfComputeKeyRotationThresholdInfo() (ConfComputeGetKeyRotationThresholdInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ConfComputeGetKeyRotationThresholdInfo, Return) {
+		return t.Interface.SystemGetConfComputeKeyRotationThresholdInfo()
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) SystemGetConfComputeSettings() (SystemConfComputeSettings, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (SystemConfComputeSettings, Return) {
+		return t.Interface.SystemGetConfComputeSettings()
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) SystemGetCudaDriverVersion() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.SystemGetCudaDriverVersion()
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) SystemGetCudaDriverVersion_v2() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.SystemGetCudaDriverVersion_v2()
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) SystemGetDriverVersion() (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.SystemGetDriverVersion()
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) SystemGetHicVersion() ([]HwbcEntry, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]HwbcEntry, Return) {
+		return t.Interface.SystemGetHicVersion()
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) SystemGetNVMLVersion() (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.SystemGetNVMLVersion()
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) SystemGetNvlinkBwMode() (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.SystemGetNvlinkBwMode()
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) SystemGetProcessName(p0 int) (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.SystemGetProcessName(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) SystemGetTopologyGpuSet(p0 int) ([]Device, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]Device, Return) {
+		return t.Interface.SystemGetTopologyGpuSet(p0)
+	})
+	return wrapDevicesWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) SystemSetConfComputeKeyRotationThresholdInfo(p0 ConfComputeSetKeyRotationThresholdInfo) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.SystemSetConfComputeKeyRotationThresholdInfo(p0)
+	})
+}
+
+func (t timeoutInterface) SystemSetNvlinkBwMode(p0 uint32) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.SystemSetNvlinkBwMode(p0)
+	})
+}
+
+func (t timeoutInterface) UnitGetCount() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.UnitGetCount()
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) UnitGetDevices(p0 Unit) ([]Device, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]Device, Return) {
+		return t.Interface.UnitGetDevices(unwrapTimeout(p0))
+	})
+	return wrapDevicesWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) UnitGetFanSpeedInfo(p0 Unit) (UnitFanSpeeds, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (UnitFanSpeeds, Return) {
+		return t.Interface.UnitGetFanSpeedInfo(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) UnitGetHandleByIndex(p0 int) (Unit, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Unit, Return) {
+		return t.Interface.UnitGetHandleByIndex(p0)
+	})
+	return wrapUnitWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) UnitGetLedState(p0 Unit) (LedState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (LedState, Return) {
+		return t.Interface.UnitGetLedState(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) UnitGetPsuInfo(p0 Unit) (PSUInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (PSUInfo, Return) {
+		return t.Interface.UnitGetPsuInfo(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) UnitGetTemperature(p0 Unit, p1 int) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.UnitGetTemperature(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) UnitGetUnitInfo(p0 Unit) (UnitInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (UnitInfo, Return) {
+		return t.Interface.UnitGetUnitInfo(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) UnitSetLedState(p0 Unit, p1 LedColor) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.UnitSetLedState(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) VgpuInstanceClearAccountingPids(p0 VgpuInstance) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.VgpuInstanceClearAccountingPids(unwrapTimeout(p0))
+	})
+}
+
+func (t timeoutInterface) VgpuInstanceGetAccountingMode(p0 VgpuInstance) (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Interface.VgpuInstanceGetAccountingMode(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetAccountingPids(p0 VgpuInstance) ([]int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]int, Return) {
+		return t.Interface.VgpuInstanceGetAccountingPids(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetAccountingStats(p0 VgpuInstance, p1 int) (AccountingStats, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (AccountingStats, Return) {
+		return t.Interface.VgpuInstanceGetAccountingStats(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetEccMode(p0 VgpuInstance) (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Interface.VgpuInstanceGetEccMode(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetEncoderCapacity(p0 VgpuInstance) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.VgpuInstanceGetEncoderCapacity(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetEncoderSessions(p0 VgpuInstance) (int, EncoderSessionInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 EncoderSessionInfo
+	}, Return) {
+		r0, r1, ret := t.Interface.VgpuInstanceGetEncoderSessions(unwrapTimeout(p0))
+		return struct {
+			r0 int
+			r1 EncoderSessionInfo
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetEncoderStats(p0 VgpuInstance) (int, uint32, uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 uint32
+		r2 uint32
+	}, Return) {
+		r0, r1, r2, ret := t.Interface.VgpuInstanceGetEncoderStats(unwrapTimeout(p0))
+		return struct {
+			r0 int
+			r1 uint32
+			r2 uint32
+		}{r0, r1, r2}, ret
+	})
+	return r.r0, r.r1, r.r2, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetFBCSessions(p0 VgpuInstance) (int, FBCSessionInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 FBCSessionInfo
+	}, Return) {
+		r0, r1, ret := t.Interface.VgpuInstanceGetFBCSessions(unwrapTimeout(p0))
+		return struct {
+			r0 int
+			r1 FBCSessionInfo
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetFBCStats(p0 VgpuInstance) (FBCStats, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (FBCStats, Return) {
+		return t.Interface.VgpuInstanceGetFBCStats(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetFbUsage(p0 VgpuInstance) (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Interface.VgpuInstanceGetFbUsage(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetFrameRateLimit(p0 VgpuInstance) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.VgpuInstanceGetFrameRateLimit(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetGpuInstanceId(p0 VgpuInstance) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.VgpuInstanceGetGpuInstanceId(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetGpuPciId(p0 VgpuInstance) (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.VgpuInstanceGetGpuPciId(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetLicenseInfo(p0 VgpuInstance) (VgpuLicenseInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuLicenseInfo, Return) {
+		return t.Interface.VgpuInstanceGetLicenseInfo(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetLicenseStatus(p0 VgpuInstance) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.VgpuInstanceGetLicenseStatus(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetMdevUUID(p0 VgpuInstance) (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.VgpuInstanceGetMdevUUID(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetMetadata(p0 VgpuInstance) (VgpuMetadata, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuMetadata, Return) {
+		return t.Interface.VgpuInstanceGetMetadata(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetType(p0 VgpuInstance) (VgpuTypeId, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuTypeId, Return) {
+		return t.Interface.VgpuInstanceGetType(unwrapTimeout(p0))
+	})
+	return wrapVgpuTypeIdWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetUUID(p0 VgpuInstance) (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.VgpuInstanceGetUUID(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetUtilization(p0 VgpuInstance, p1 uint64) (ValueType, VgpuInstanceUtilizationSample, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 ValueType
+		r1 VgpuInstanceUtilizationSample
+	}, Return) {
+		r0, r1, ret := t.Interface.VgpuInstanceGetUtilization(unwrapTimeout(p0), p1)
+		return struct {
+			r0 ValueType
+			r1 VgpuInstanceUtilizationSample
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetVmDriverVersion(p0 VgpuInstance) (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.VgpuInstanceGetVmDriverVersion(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuInstanceGetVmID(p0 VgpuInstance) (string, VgpuVmIdType, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 string
+		r1 VgpuVmIdType
+	}, Return) {
+		r0, r1, ret := t.Interface.VgpuInstanceGetVmID(unwrapTimeout(p0))
+		return struct {
+			r0 string
+			r1 VgpuVmIdType
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) VgpuInstanceSetEncoderCapacity(p0 VgpuInstance, p1 int) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Interface.VgpuInstanceSetEncoderCapacity(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutInterface) VgpuTypeGetCapabilities(p0 VgpuTypeId, p1 VgpuCapability) (bool, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (bool, Return) {
+		return t.Interface.VgpuTypeGetCapabilities(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuTypeGetClass(p0 VgpuTypeId) (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.VgpuTypeGetClass(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuTypeGetDeviceID(p0 VgpuTypeId) (uint64, uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint64
+		r1 uint64
+	}, Return) {
+		r0, r1, ret := t.Interface.VgpuTypeGetDeviceID(unwrapTimeout(p0))
+		return struct {
+			r0 uint64
+			r1 uint64
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutInterface) VgpuTypeGetFrameRateLimit(p0 VgpuTypeId) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.VgpuTypeGetFrameRateLimit(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuTypeGetFramebufferSize(p0 VgpuTypeId) (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Interface.VgpuTypeGetFramebufferSize(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuTypeGetGpuInstanceProfileId(p0 VgpuTypeId) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Interface.VgpuTypeGetGpuInstanceProfileId(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuTypeGetLicense(p0 VgpuTypeId) (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.VgpuTypeGetLicense(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuTypeGetMaxInstances(p0 Device, p1 VgpuTypeId) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.VgpuTypeGetMaxInstances(unwrapTimeout(p0), unwrapTimeout(p1))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuTypeGetMaxInstancesPerVm(p0 VgpuTypeId) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.VgpuTypeGetMaxInstancesPerVm(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuTypeGetName(p0 VgpuTypeId) (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Interface.VgpuTypeGetName(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuTypeGetNumDisplayHeads(p0 VgpuTypeId) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Interface.VgpuTypeGetNumDisplayHeads(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutInterface) VgpuTypeGetResolution(p0 VgpuTypeId, p1 int) (uint32, uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint32
+		r1 uint32
+	}, Return) {
+		r0, r1, ret := t.Interface.VgpuTypeGetResolution(unwrapTimeout(p0), p1)
+		return struct {
+			r0 uint32
+			r1 uint32
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) ClearAccountingPids() Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.ClearAccountingPids()
+	})
+}
+
+func (t timeoutDevice) ClearCpuAffinity() Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.ClearCpuAffinity()
+	})
+}
+
+func (t timeoutDevice) ClearEccErrorCounts(p0 EccCounterType) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.ClearEccErrorCounts(p0)
+	})
+}
+
+func (t timeoutDevice) ClearFieldValues(p0 []FieldValue) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.ClearFieldValues(p0)
+	})
+}
+
+func (t timeoutDevice) CreateGpuInstance(p0 *GpuInstanceProfileInfo) (GpuInstance, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuInstance, Return) {
+		return t.Device.CreateGpuInstance(p0)
+	})
+	return wrapGpuInstanceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutDevice) CreateGpuInstanceWithPlacement(p0 *GpuInstanceProfileInfo, p1 *GpuInstancePlacement) (GpuInstance, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuInstance, Return) {
+		return t.Device.CreateGpuInstanceWithPlacement(p0, p1)
+	})
+	return wrapGpuInstanceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutDevice) FreezeNvLinkUtilizationCounter(p0 int, p1 int, p2 EnableState) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.FreezeNvLinkUtilizationCounter(p0, p1, p2)
+	})
+}
+
+func (t timeoutDevice) GetAPIRestriction(p0 RestrictedAPI) (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Device.GetAPIRestriction(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetAccountingBufferSize() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetAccountingBufferSize()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetAccountingMode() (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Device.GetAccountingMode()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetAccountingPids() ([]int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]int, Return) {
+		return t.Device.GetAccountingPids()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetAccountingStats(p0 uint32) (AccountingStats, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (AccountingStats, Return) {
+		return t.Device.GetAccountingStats(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetActiveVgpus() ([]VgpuInstance, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]VgpuInstance, Return) {
+		return t.Device.GetActiveVgpus()
+	})
+	return wrapVgpuInstancesWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutDevice) GetAdaptiveClockInfoStatus() (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetAdaptiveClockInfoStatus()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetApplicationsClock(p0 ClockType) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetApplicationsClock(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetArchitecture() (DeviceArchitecture, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (DeviceArchitecture, Return) {
+		return t.Device.GetArchitecture()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetAttributes() (DeviceAttributes, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (DeviceAttributes, Return) {
+		return t.Device.GetAttributes()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetAutoBoostedClocksEnabled() (EnableState, EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 EnableState
+		r1 EnableState
+	}, Return) {
+		r0, r1, ret := t.Device.GetAutoBoostedClocksEnabled()
+		return struct {
+			r0 EnableState
+			r1 EnableState
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetBAR1MemoryInfo() (BAR1Memory, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (BAR1Memory, Return) {
+		return t.Device.GetBAR1MemoryInfo()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetBoardId() (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetBoardId()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetBoardPartNumber() (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Device.GetBoardPartNumber()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetBrand() (BrandType, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (BrandType, Return) {
+		return t.Device.GetBrand()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetBridgeChipInfo() (BridgeChipHierarchy, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (BridgeChipHierarchy, Return) {
+		return t.Device.GetBridgeChipInfo()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetBusType() (BusType, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (BusType, Return) {
+		return t.Device.GetBusType()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetClkMonStatus() (ClkMonStatus, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ClkMonStatus, Return) {
+		return t.Device.GetClkMonStatus()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetClock(p0 ClockType, p1 ClockId) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetClock(p0, p1)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetClockInfo(p0 ClockType) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetClockInfo(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetComputeInstanceId() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetComputeInstanceId()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetComputeMode() (ComputeMode, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ComputeMode, Return) {
+		return t.Device.GetComputeMode()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetComputeRunningProcesses() ([]ProcessInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]ProcessInfo, Return) {
+		return t.Device.GetComputeRunningProcesses()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetConfComputeGpuAttestationReport() (ConfComputeGpuAttestationReport, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ConfComputeGpuAttestationReport, Return) {
+		return t.Device.GetConfComputeGpuAttestationReport()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetConfComputeGpuCertificate() (ConfComputeGpuCertificate, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ConfComputeGpuCertificate, Return) {
+		return t.Device.GetConfComputeGpuCertificate()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetConfComputeMemSizeInfo() (ConfComputeMemSizeInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ConfComputeMemSizeInfo, Return) {
+		return t.Device.GetConfComputeMemSizeInfo()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetConfComputeProtectedMemoryUsage() (Memory, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Memory, Return) {
+		return t.Device.GetConfComputeProtectedMemoryUsage()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetCpuAffinity(p0 int) ([]uint, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]uint, Return) {
+		return t.Device.GetCpuAffinity(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetCpuAffinityWithinScope(p0 int, p1 AffinityScope) ([]uint, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]uint, Return) {
+		return t.Device.GetCpuAffinityWithinScope(p0, p1)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetCreatableVgpus() ([]VgpuTypeId, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]VgpuTypeId, Return) {
+		return t.Device.GetCreatableVgpus()
+	})
+	return wrapVgpuTypeIdsWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutDevice) GetCudaComputeCapability() (int, int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 int
+	}, Return) {
+		r0, r1, ret := t.Device.GetCudaComputeCapability()
+		return struct {
+			r0 int
+			r1 int
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetCurrPcieLinkGeneration() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetCurrPcieLinkGeneration()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetCurrPcieLinkWidth() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetCurrPcieLinkWidth()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetCurrentClocksEventReasons() (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Device.GetCurrentClocksEventReasons()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetCurrentClocksThrottleReasons() (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Device.GetCurrentClocksThrottleReasons()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetDecoderUtilization() (uint32, uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint32
+		r1 uint32
+	}, Return) {
+		r0, r1, ret := t.Device.GetDecoderUtilization()
+		return struct {
+			r0 uint32
+			r1 uint32
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetDefaultApplicationsClock(p0 ClockType) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetDefaultApplicationsClock(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetDefaultEccMode() (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Device.GetDefaultEccMode()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetDetailedEccErrors(p0 MemoryErrorType, p1 EccCounterType) (EccErrorCounts, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EccErrorCounts, Return) {
+		return t.Device.GetDetailedEccErrors(p0, p1)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetDeviceHandleFromMigDeviceHandle() (Device, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Device, Return) {
+		return t.Device.GetDeviceHandleFromMigDeviceHandle()
+	})
+	return wrapDeviceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutDevice) GetDisplayActive() (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Device.GetDisplayActive()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetDisplayMode() (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Device.GetDisplayMode()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetDriverModel() (DriverModel, DriverModel, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 DriverModel
+		r1 DriverModel
+	}, Return) {
+		r0, r1, ret := t.Device.GetDriverModel()
+		return struct {
+			r0 DriverModel
+			r1 DriverModel
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetDynamicPstatesInfo() (GpuDynamicPstatesInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuDynamicPstatesInfo, Return) {
+		return t.Device.GetDynamicPstatesInfo()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetEccMode() (EnableState, EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 EnableState
+		r1 EnableState
+	}, Return) {
+		r0, r1, ret := t.Device.GetEccMode()
+		return struct {
+			r0 EnableState
+			r1 EnableState
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetEncoderCapacity(p0 EncoderType) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetEncoderCapacity(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetEncoderSessions() ([]EncoderSessionInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]EncoderSessionInfo, Return) {
+		return t.Device.GetEncoderSessions()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetEncoderStats() (int, uint32, uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 uint32
+		r2 uint32
+	}, Return) {
+		r0, r1, r2, ret := t.Device.GetEncoderStats()
+		return struct {
+			r0 int
+			r1 uint32
+			r2 uint32
+		}{r0, r1, r2}, ret
+	})
+	return r.r0, r.r1, r.r2, ret
+}
+
+func (t timeoutDevice) GetEncoderUtilization() (uint32, uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint32
+		r1 uint32
+	}, Return) {
+		r0, r1, ret := t.Device.GetEncoderUtilization()
+		return struct {
+			r0 uint32
+			r1 uint32
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetEnforcedPowerLimit() (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetEnforcedPowerLimit()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetFBCSessions() ([]FBCSessionInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]FBCSessionInfo, Return) {
+		return t.Device.GetFBCSessions()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetFBCStats() (FBCStats, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (FBCStats, Return) {
+		return t.Device.GetFBCStats()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetFanControlPolicy_v2(p0 int) (FanControlPolicy, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (FanControlPolicy, Return) {
+		return t.Device.GetFanControlPolicy_v2(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetFanSpeed() (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetFanSpeed()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetFanSpeed_v2(p0 int) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetFanSpeed_v2(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetFieldValues(p0 []FieldValue) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.GetFieldValues(p0)
+	})
+}
+
+func (t timeoutDevice) GetGpcClkMinMaxVfOffset() (int, int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 int
+	}, Return) {
+		r0, r1, ret := t.Device.GetGpcClkMinMaxVfOffset()
+		return struct {
+			r0 int
+			r1 int
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetGpcClkVfOffset() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetGpcClkVfOffset()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetGpuFabricInfo() (GpuFabricInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuFabricInfo, Return) {
+		return t.Device.GetGpuFabricInfo()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetGpuInstanceById(p0 int) (GpuInstance, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuInstance, Return) {
+		return t.Device.GetGpuInstanceById(p0)
+	})
+	return wrapGpuInstanceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutDevice) GetGpuInstanceId() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetGpuInstanceId()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetGpuInstancePossiblePlacements(p0 *GpuInstanceProfileInfo) ([]GpuInstancePlacement, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]GpuInstancePlacement, Return) {
+		return t.Device.GetGpuInstancePossiblePlacements(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetGpuInstanceProfileInfo(p0 int) (GpuInstanceProfileInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuInstanceProfileInfo, Return) {
+		return t.Device.GetGpuInstanceProfileInfo(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetGpuInstanceRemainingCapacity(p0 *GpuInstanceProfileInfo) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetGpuInstanceRemainingCapacity(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetGpuInstances(p0 *GpuInstanceProfileInfo) ([]GpuInstance, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]GpuInstance, Return) {
+		return t.Device.GetGpuInstances(p0)
+	})
+	return wrapGpuInstancesWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutDevice) GetGpuMaxPcieLinkGeneration() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetGpuMaxPcieLinkGeneration()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetGpuOperationMode() (GpuOperationMode, GpuOperationMode, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 GpuOperationMode
+		r1 GpuOperationMode
+	}, Return) {
+		r0, r1, ret := t.Device.GetGpuOperationMode()
+		return struct {
+			r0 GpuOperationMode
+			r1 GpuOperationMode
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetGraphicsRunningProcesses() ([]ProcessInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]ProcessInfo, Return) {
+		return t.Device.GetGraphicsRunningProcesses()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetGridLicensableFeatures() (GridLicensableFeatures, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GridLicensableFeatures, Return) {
+		return t.Device.GetGridLicensableFeatures()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetGspFirmwareMode() (bool, bool, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 bool
+		r1 bool
+	}, Return) {
+		r0, r1, ret := t.Device.GetGspFirmwareMode()
+		return struct {
+			r0 bool
+			r1 bool
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetGspFirmwareVersion() (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Device.GetGspFirmwareVersion()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetHostVgpuMode() (HostVgpuMode, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (HostVgpuMode, Return) {
+		return t.Device.GetHostVgpuMode()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetIndex() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetIndex()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetInforomConfigurationChecksum() (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetInforomConfigurationChecksum()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetInforomImageVersion() (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Device.GetInforomImageVersion()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetInforomVersion(p0 InforomObject) (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Device.GetInforomVersion(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetIrqNum() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetIrqNum()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetJpgUtilization() (uint32, uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint32
+		r1 uint32
+	}, Return) {
+		r0, r1, ret := t.Device.GetJpgUtilization()
+		return struct {
+			r0 uint32
+			r1 uint32
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetLastBBXFlushTime() (uint64, uint, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint64
+		r1 uint
+	}, Return) {
+		r0, r1, ret := t.Device.GetLastBBXFlushTime()
+		return struct {
+			r0 uint64
+			r1 uint
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetMPSComputeRunningProcesses() ([]ProcessInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]ProcessInfo, Return) {
+		return t.Device.GetMPSComputeRunningProcesses()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetMaxClockInfo(p0 ClockType) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetMaxClockInfo(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetMaxCustomerBoostClock(p0 ClockType) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetMaxCustomerBoostClock(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetMaxMigDeviceCount() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetMaxMigDeviceCount()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetMaxPcieLinkGeneration() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetMaxPcieLinkGeneration()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetMaxPcieLinkWidth() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetMaxPcieLinkWidth()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetMemClkMinMaxVfOffset() (int, int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 int
+	}, Return) {
+		r0, r1, ret := t.Device.GetMemClkMinMaxVfOffset()
+		return struct {
+			r0 int
+			r1 int
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetMemClkVfOffset() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetMemClkVfOffset()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetMemoryAffinity(p0 int, p1 AffinityScope) ([]uint, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]uint, Return) {
+		return t.Device.GetMemoryAffinity(p0, p1)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetMemoryBusWidth() (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetMemoryBusWidth()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetMemoryErrorCounter(p0 MemoryErrorType, p1 EccCounterType, p2 MemoryLocation) (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Device.GetMemoryErrorCounter(p0, p1, p2)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetMemoryInfo() (Memory, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Memory, Return) {
+		return t.Device.GetMemoryInfo()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetMemoryInfo_v2() (Memory_v2, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Memory_v2, Return) {
+		return t.Device.GetMemoryInfo_v2()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetMigDeviceHandleByIndex(p0 int) (Device, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Device, Return) {
+		return t.Device.GetMigDeviceHandleByIndex(p0)
+	})
+	return wrapDeviceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutDevice) GetMigMode() (int, int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 int
+	}, Return) {
+		r0, r1, ret := t.Device.GetMigMode()
+		return struct {
+			r0 int
+			r1 int
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetMinMaxClockOfPState(p0 ClockType, p1 Pstates) (uint32, uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint32
+		r1 uint32
+	}, Return) {
+		r0, r1, ret := t.Device.GetMinMaxClockOfPState(p0, p1)
+		return struct {
+			r0 uint32
+			r1 uint32
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetMinMaxFanSpeed() (int, int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 int
+	}, Return) {
+		r0, r1, ret := t.Device.GetMinMaxFanSpeed()
+		return struct {
+			r0 int
+			r1 int
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetMinorNumber() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetMinorNumber()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetModuleId() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetModuleId()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetMultiGpuBoard() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetMultiGpuBoard()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetName() (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Device.GetName()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetNumFans() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetNumFans()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetNumGpuCores() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetNumGpuCores()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetNumaNodeId() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetNumaNodeId()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetNvLinkCapability(p0 int, p1 NvLinkCapability) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetNvLinkCapability(p0, p1)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetNvLinkErrorCounter(p0 int, p1 NvLinkErrorCounter) (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Device.GetNvLinkErrorCounter(p0, p1)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetNvLinkRemoteDeviceType(p0 int) (IntNvLinkDeviceType, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (IntNvLinkDeviceType, Return) {
+		return t.Device.GetNvLinkRemoteDeviceType(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetNvLinkRemotePciInfo(p0 int) (PciInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (PciInfo, Return) {
+		return t.Device.GetNvLinkRemotePciInfo(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetNvLinkState(p0 int) (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Device.GetNvLinkState(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetNvLinkUtilizationControl(p0 int, p1 int) (NvLinkUtilizationControl, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (NvLinkUtilizationControl, Return) {
+		return t.Device.GetNvLinkUtilizationControl(p0, p1)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetNvLinkUtilizationCounter(p0 int, p1 int) (uint64, uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint64
+		r1 uint64
+	}, Return) {
+		r0, r1, ret := t.Device.GetNvLinkUtilizationCounter(p0, p1)
+		return struct {
+			r0 uint64
+			r1 uint64
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetNvLinkVersion(p0 int) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetNvLinkVersion(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetOfaUtilization() (uint32, uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint32
+		r1 uint32
+	}, Return) {
+		r0, r1, ret := t.Device.GetOfaUtilization()
+		return struct {
+			r0 uint32
+			r1 uint32
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetP2PStatus(p0 Device, p1 GpuP2PCapsIndex) (GpuP2PStatus, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuP2PStatus, Return) {
+		return t.Device.GetP2PStatus(unwrapTimeout(p0), p1)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetPciInfo() (PciInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (PciInfo, Return) {
+		return t.Device.GetPciInfo()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetPciInfoExt() (PciInfoExt, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (PciInfoExt, Return) {
+		return t.Device.GetPciInfoExt()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetPcieLinkMaxSpeed() (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetPcieLinkMaxSpeed()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetPcieReplayCounter() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetPcieReplayCounter()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetPcieSpeed() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetPcieSpeed()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetPcieThroughput(p0 PcieUtilCounter) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetPcieThroughput(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetPerformanceState() (Pstates, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Pstates, Return) {
+		return t.Device.GetPerformanceState()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetPersistenceMode() (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Device.GetPersistenceMode()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetPgpuMetadataString() (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Device.GetPgpuMetadataString()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetPowerManagementDefaultLimit() (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetPowerManagementDefaultLimit()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetPowerManagementLimit() (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetPowerManagementLimit()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetPowerManagementLimitConstraints() (uint32, uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint32
+		r1 uint32
+	}, Return) {
+		r0, r1, ret := t.Device.GetPowerManagementLimitConstraints()
+		return struct {
+			r0 uint32
+			r1 uint32
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetPowerManagementMode() (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Device.GetPowerManagementMode()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetPowerSource() (PowerSource, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (PowerSource, Return) {
+		return t.Device.GetPowerSource()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetPowerState() (Pstates, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Pstates, Return) {
+		return t.Device.GetPowerState()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetPowerUsage() (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetPowerUsage()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetProcessUtilization(p0 uint64) ([]ProcessUtilizationSample, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]ProcessUtilizationSample, Return) {
+		return t.Device.GetProcessUtilization(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetProcessesUtilizationInfo(p0 uint64) (ProcessesUtilizationInfo, []ProcessUtilizationInfo_v1, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 ProcessesUtilizationInfo
+		r1 []ProcessUtilizationInfo_v1
+	}, Return) {
+		r0, r1, ret := t.Device.GetProcessesUtilizationInfo(p0)
+		return struct {
+			r0 ProcessesUtilizationInfo
+			r1 []ProcessUtilizationInfo_v1
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetRemappedRows() (int, int, bool, bool, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 int
+		r2 bool
+		r3 bool
+	}, Return) {
+		r0, r1, r2, r3, ret := t.Device.GetRemappedRows()
+		return struct {
+			r0 int
+			r1 int
+			r2 bool
+			r3 bool
+		}{r0, r1, r2, r3}, ret
+	})
+	return r.r0, r.r1, r.r2, r.r3, ret
+}
+
+func (t timeoutDevice) GetRetiredPages(p0 PageRetirementCause) ([]uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]uint64, Return) {
+		return t.Device.GetRetiredPages(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetRetiredPagesPendingStatus() (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.Device.GetRetiredPagesPendingStatus()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetRetiredPages_v2(p0 PageRetirementCause) ([]uint64, []uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 []uint64
+		r1 []uint64
+	}, Return) {
+		r0, r1, ret := t.Device.GetRetiredPages_v2(p0)
+		return struct {
+			r0 []uint64
+			r1 []uint64
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetRowRemapperHistogram() (RowRemapperHistogramValues, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (RowRemapperHistogramValues, Return) {
+		return t.Device.GetRowRemapperHistogram()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetRunningProcessDetailList() (ProcessDetailList, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ProcessDetailList, Return) {
+		return t.Device.GetRunningProcessDetailList()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetSamples(p0 SamplingType, p1 uint64) (ValueType, []Sample, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 ValueType
+		r1 []Sample
+	}, Return) {
+		r0, r1, ret := t.Device.GetSamples(p0, p1)
+		return struct {
+			r0 ValueType
+			r1 []Sample
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetSerial() (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Device.GetSerial()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetSramEccErrorStatus() (EccSramErrorStatus, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EccSramErrorStatus, Return) {
+		return t.Device.GetSramEccErrorStatus()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetSupportedClocksEventReasons() (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Device.GetSupportedClocksEventReasons()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetSupportedClocksThrottleReasons() (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Device.GetSupportedClocksThrottleReasons()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetSupportedEventTypes() (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Device.GetSupportedEventTypes()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetSupportedGraphicsClocks(p0 int) (int, []uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 []uint32
+	}, Return) {
+		r0, r1, ret := t.Device.GetSupportedGraphicsClocks(p0)
+		return struct {
+			r0 int
+			r1 []uint32
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetSupportedMemoryClocks() (int, []uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 []uint32
+	}, Return) {
+		r0, r1, ret := t.Device.GetSupportedMemoryClocks()
+		return struct {
+			r0 int
+			r1 []uint32
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetSupportedPerformanceStates() ([]Pstates, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]Pstates, Return) {
+		return t.Device.GetSupportedPerformanceStates()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetSupportedVgpus() ([]VgpuTypeId, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]VgpuTypeId, Return) {
+		return t.Device.GetSupportedVgpus()
+	})
+	return wrapVgpuTypeIdsWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutDevice) GetTargetFanSpeed(p0 int) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.GetTargetFanSpeed(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetTemperature(p0 TemperatureSensors) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetTemperature(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetTemperatureThreshold(p0 TemperatureThresholds) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GetTemperatureThreshold(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetThermalSettings(p0 uint32) (GpuThermalSettings, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuThermalSettings, Return) {
+		return t.Device.GetThermalSettings(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetTopologyCommonAncestor(p0 Device) (GpuTopologyLevel, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuTopologyLevel, Return) {
+		return t.Device.GetTopologyCommonAncestor(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetTopologyNearestGpus(p0 GpuTopologyLevel) ([]Device, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]Device, Return) {
+		return t.Device.GetTopologyNearestGpus(p0)
+	})
+	return wrapDevicesWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutDevice) GetTotalEccErrors(p0 MemoryErrorType, p1 EccCounterType) (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Device.GetTotalEccErrors(p0, p1)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetTotalEnergyConsumption() (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.Device.GetTotalEnergyConsumption()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetUUID() (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Device.GetUUID()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetUtilizationRates() (Utilization, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Utilization, Return) {
+		return t.Device.GetUtilizationRates()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetVbiosVersion() (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.Device.GetVbiosVersion()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetVgpuCapabilities(p0 DeviceVgpuCapability) (bool, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (bool, Return) {
+		return t.Device.GetVgpuCapabilities(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetVgpuHeterogeneousMode() (VgpuHeterogeneousMode, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuHeterogeneousMode, Return) {
+		return t.Device.GetVgpuHeterogeneousMode()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetVgpuInstancesUtilizationInfo() (VgpuInstancesUtilizationInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuInstancesUtilizationInfo, Return) {
+		return t.Device.GetVgpuInstancesUtilizationInfo()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetVgpuMetadata() (VgpuPgpuMetadata, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuPgpuMetadata, Return) {
+		return t.Device.GetVgpuMetadata()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetVgpuProcessUtilization(p0 uint64) ([]VgpuProcessUtilizationSample, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]VgpuProcessUtilizationSample, Return) {
+		return t.Device.GetVgpuProcessUtilization(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetVgpuProcessesUtilizationInfo() (VgpuProcessesUtilizationInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuProcessesUtilizationInfo, Return) {
+		return t.Device.GetVgpuProcessesUtilizationInfo()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetVgpuSchedulerCapabilities() (VgpuSchedulerCapabilities, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuSchedulerCapabilities, Return) {
+		return t.Device.GetVgpuSchedulerCapabilities()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetVgpuSchedulerLog() (VgpuSchedulerLog, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuSchedulerLog, Return) {
+		return t.Device.GetVgpuSchedulerLog()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetVgpuSchedulerState() (VgpuSchedulerGetState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuSchedulerGetState, Return) {
+		return t.Device.GetVgpuSchedulerState()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetVgpuTypeCreatablePlacements(p0 VgpuTypeId) (VgpuPlacementList, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuPlacementList, Return) {
+		return t.Device.GetVgpuTypeCreatablePlacements(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetVgpuTypeSupportedPlacements(p0 VgpuTypeId) (VgpuPlacementList, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuPlacementList, Return) {
+		return t.Device.GetVgpuTypeSupportedPlacements(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetVgpuUtilization(p0 uint64) (ValueType, []VgpuInstanceUtilizationSample, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 ValueType
+		r1 []VgpuInstanceUtilizationSample
+	}, Return) {
+		r0, r1, ret := t.Device.GetVgpuUtilization(p0)
+		return struct {
+			r0 ValueType
+			r1 []VgpuInstanceUtilizationSample
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutDevice) GetViolationStatus(p0 PerfPolicyType) (ViolationTime, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ViolationTime, Return) {
+		return t.Device.GetViolationStatus(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GetVirtualizationMode() (GpuVirtualizationMode, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuVirtualizationMode, Return) {
+		return t.Device.GetVirtualizationMode()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GpmMigSampleGet(p0 int, p1 GpmSample) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.GpmMigSampleGet(p0, unwrapTimeout(p1))
+	})
+}
+
+func (t timeoutDevice) GpmQueryDeviceSupport() (GpmSupport, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpmSupport, Return) {
+		return t.Device.GpmQueryDeviceSupport()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GpmQueryIfStreamingEnabled() (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Device.GpmQueryIfStreamingEnabled()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) GpmSampleGet(p0 GpmSample) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.GpmSampleGet(unwrapTimeout(p0))
+	})
+}
+
+func (t timeoutDevice) GpmSetStreamingEnabled(p0 uint32) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.GpmSetStreamingEnabled(p0)
+	})
+}
+
+func (t timeoutDevice) IsMigDeviceHandle() (bool, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (bool, Return) {
+		return t.Device.IsMigDeviceHandle()
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) OnSameBoard(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.OnSameBoard(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) RegisterEvents(p0 uint64, p1 EventSet) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.RegisterEvents(p0, unwrapTimeout(p1))
+	})
+}
+
+func (t timeoutDevice) ResetApplicationsClocks() Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.ResetApplicationsClocks()
+	})
+}
+
+func (t timeoutDevice) ResetGpuLockedClocks() Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.ResetGpuLockedClocks()
+	})
+}
+
+func (t timeoutDevice) ResetMemoryLockedClocks() Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.ResetMemoryLockedClocks()
+	})
+}
+
+func (t timeoutDevice) ResetNvLinkErrorCounters(p0 int) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.ResetNvLinkErrorCounters(p0)
+	})
+}
+
+func (t timeoutDevice) ResetNvLinkUtilizationCounter(p0 int, p1 int) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.ResetNvLinkUtilizationCounter(p0, p1)
+	})
+}
+
+func (t timeoutDevice) SetAPIRestriction(p0 RestrictedAPI, p1 EnableState) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetAPIRestriction(p0, p1)
+	})
+}
+
+func (t timeoutDevice) SetAccountingMode(p0 EnableState) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetAccountingMode(p0)
+	})
+}
+
+func (t timeoutDevice) SetApplicationsClocks(p0 uint32, p1 uint32) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetApplicationsClocks(p0, p1)
+	})
+}
+
+func (t timeoutDevice) SetAutoBoostedClocksEnabled(p0 EnableState) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetAutoBoostedClocksEnabled(p0)
+	})
+}
+
+func (t timeoutDevice) SetComputeMode(p0 ComputeMode) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetComputeMode(p0)
+	})
+}
+
+func (t timeoutDevice) SetConfComputeUnprotectedMemSize(p0 uint64) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetConfComputeUnprotectedMemSize(p0)
+	})
+}
+
+func (t timeoutDevice) SetCpuAffinity() Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetCpuAffinity()
+	})
+}
+
+func (t timeoutDevice) SetDefaultAutoBoostedClocksEnabled(p0 EnableState, p1 uint32) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetDefaultAutoBoostedClocksEnabled(p0, p1)
+	})
+}
+
+func (t timeoutDevice) SetDefaultFanSpeed_v2(p0 int) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetDefaultFanSpeed_v2(p0)
+	})
+}
+
+func (t timeoutDevice) SetDriverModel(p0 DriverModel, p1 uint32) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetDriverModel(p0, p1)
+	})
+}
+
+func (t timeoutDevice) SetEccMode(p0 EnableState) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetEccMode(p0)
+	})
+}
+
+func (t timeoutDevice) SetFanControlPolicy(p0 int, p1 FanControlPolicy) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetFanControlPolicy(p0, p1)
+	})
+}
+
+func (t timeoutDevice) SetFanSpeed_v2(p0 int, p1 int) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetFanSpeed_v2(p0, p1)
+	})
+}
+
+func (t timeoutDevice) SetGpcClkVfOffset(p0 int) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetGpcClkVfOffset(p0)
+	})
+}
+
+func (t timeoutDevice) SetGpuLockedClocks(p0 uint32, p1 uint32) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetGpuLockedClocks(p0, p1)
+	})
+}
+
+func (t timeoutDevice) SetGpuOperationMode(p0 GpuOperationMode) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetGpuOperationMode(p0)
+	})
+}
+
+func (t timeoutDevice) SetMemClkVfOffset(p0 int) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetMemClkVfOffset(p0)
+	})
+}
+
+func (t timeoutDevice) SetMemoryLockedClocks(p0 uint32, p1 uint32) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetMemoryLockedClocks(p0, p1)
+	})
+}
+
+func (t timeoutDevice) SetMigMode(p0 int) (Return, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (Return, Return) {
+		return t.Device.SetMigMode(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutDevice) SetNvLinkDeviceLowPowerThreshold(p0 *NvLinkPowerThres) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetNvLinkDeviceLowPowerThreshold(p0)
+	})
+}
+
+func (t timeoutDevice) SetNvLinkUtilizationControl(p0 int, p1 int, p2 *NvLinkUtilizationControl, p3 bool) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetNvLinkUtilizationControl(p0, p1, p2, p3)
+	})
+}
+
+func (t timeoutDevice) SetPersistenceMode(p0 EnableState) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetPersistenceMode(p0)
+	})
+}
+
+func (t timeoutDevice) SetPowerManagementLimit(p0 uint32) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetPowerManagementLimit(p0)
+	})
+}
+
+func (t timeoutDevice) SetPowerManagementLimit_v2(p0 *PowerValue_v2) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetPowerManagementLimit_v2(p0)
+	})
+}
+
+func (t timeoutDevice) SetTemperatureThreshold(p0 TemperatureThresholds, p1 int) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetTemperatureThreshold(p0, p1)
+	})
+}
+
+func (t timeoutDevice) SetVgpuCapabilities(p0 DeviceVgpuCapability, p1 EnableState) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetVgpuCapabilities(p0, p1)
+	})
+}
+
+func (t timeoutDevice) SetVgpuHeterogeneousMode(p0 VgpuHeterogeneousMode) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetVgpuHeterogeneousMode(p0)
+	})
+}
+
+func (t timeoutDevice) SetVgpuSchedulerState(p0 *VgpuSchedulerSetState) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetVgpuSchedulerState(p0)
+	})
+}
+
+func (t timeoutDevice) SetVirtualizationMode(p0 GpuVirtualizationMode) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.SetVirtualizationMode(p0)
+	})
+}
+
+func (t timeoutDevice) ValidateInforom() Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Device.ValidateInforom()
+	})
+}
+
+func (t timeoutDevice) VgpuTypeGetMaxInstances(p0 VgpuTypeId) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.Device.VgpuTypeGetMaxInstances(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutGpuInstance) CreateComputeInstance(p0 *ComputeInstanceProfileInfo) (ComputeInstance, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ComputeInstance, Return) {
+		return t.GpuInstance.CreateComputeInstance(p0)
+	})
+	return wrapComputeInstanceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutGpuInstance) CreateComputeInstanceWithPlacement(p0 *ComputeInstanceProfileInfo, p1 *ComputeInstancePlacement) (ComputeInstance, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ComputeInstance, Return) {
+		return t.GpuInstance.CreateComputeInstanceWithPlacement(p0, p1)
+	})
+	return wrapComputeInstanceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutGpuInstance) Destroy() Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.GpuInstance.Destroy()
+	})
+}
+
+func (t timeoutGpuInstance) GetComputeInstanceById(p0 int) (ComputeInstance, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ComputeInstance, Return) {
+		return t.GpuInstance.GetComputeInstanceById(p0)
+	})
+	return wrapComputeInstanceWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutGpuInstance) GetComputeInstancePossiblePlacements(p0 *ComputeInstanceProfileInfo) ([]ComputeInstancePlacement, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]ComputeInstancePlacement, Return) {
+		return t.GpuInstance.GetComputeInstancePossiblePlacements(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutGpuInstance) GetComputeInstanceProfileInfo(p0 int, p1 int) (ComputeInstanceProfileInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ComputeInstanceProfileInfo, Return) {
+		return t.GpuInstance.GetComputeInstanceProfileInfo(p0, p1)
+	})
+	return r, ret
+}
+
+func (t timeoutGpuInstance) GetComputeInstanceRemainingCapacity(p0 *ComputeInstanceProfileInfo) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.GpuInstance.GetComputeInstanceRemainingCapacity(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutGpuInstance) GetComputeInstances(p0 *ComputeInstanceProfileInfo) ([]ComputeInstance, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]ComputeInstance, Return) {
+		return t.GpuInstance.GetComputeInstances(p0)
+	})
+	return wrapComputeInstancesWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutGpuInstance) GetInfo() (GpuInstanceInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (GpuInstanceInfo, Return) {
+		return t.GpuInstance.GetInfo()
+	})
+	return r, ret
+}
+
+func (t timeoutComputeInstance) Destroy() Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.ComputeInstance.Destroy()
+	})
+}
+
+func (t timeoutComputeInstance) GetInfo() (ComputeInstanceInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (ComputeInstanceInfo, Return) {
+		return t.ComputeInstance.GetInfo()
+	})
+	return r, ret
+}
+
+func (t timeoutEventSet) Free() Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.EventSet.Free()
+	})
+}
+
+func (t timeoutEventSet) Wait(p0 uint32) (EventData, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EventData, Return) {
+		return t.EventSet.Wait(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutGpmSample) Free() Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.GpmSample.Free()
+	})
+}
+
+func (t timeoutGpmSample) Get(p0 Device) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.GpmSample.Get(unwrapTimeout(p0))
+	})
+}
+
+func (t timeoutGpmSample) MigGet(p0 Device, p1 int) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.GpmSample.MigGet(unwrapTimeout(p0), p1)
+	})
+}
+
+func (t timeoutUnit) GetDevices() ([]Device, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]Device, Return) {
+		return t.Unit.GetDevices()
+	})
+	return wrapDevicesWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutUnit) GetFanSpeedInfo() (UnitFanSpeeds, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (UnitFanSpeeds, Return) {
+		return t.Unit.GetFanSpeedInfo()
+	})
+	return r, ret
+}
+
+func (t timeoutUnit) GetLedState() (LedState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (LedState, Return) {
+		return t.Unit.GetLedState()
+	})
+	return r, ret
+}
+
+func (t timeoutUnit) GetPsuInfo() (PSUInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (PSUInfo, Return) {
+		return t.Unit.GetPsuInfo()
+	})
+	return r, ret
+}
+
+func (t timeoutUnit) GetTemperature(p0 int) (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.Unit.GetTemperature(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutUnit) GetUnitInfo() (UnitInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (UnitInfo, Return) {
+		return t.Unit.GetUnitInfo()
+	})
+	return r, ret
+}
+
+func (t timeoutUnit) SetLedState(p0 LedColor) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.Unit.SetLedState(p0)
+	})
+}
+
+func (t timeoutVgpuInstance) ClearAccountingPids() Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.VgpuInstance.ClearAccountingPids()
+	})
+}
+
+func (t timeoutVgpuInstance) GetAccountingMode() (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.VgpuInstance.GetAccountingMode()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuInstance) GetAccountingPids() ([]int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() ([]int, Return) {
+		return t.VgpuInstance.GetAccountingPids()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuInstance) GetAccountingStats(p0 int) (AccountingStats, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (AccountingStats, Return) {
+		return t.VgpuInstance.GetAccountingStats(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuInstance) GetEccMode() (EnableState, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (EnableState, Return) {
+		return t.VgpuInstance.GetEccMode()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuInstance) GetEncoderCapacity() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.VgpuInstance.GetEncoderCapacity()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuInstance) GetEncoderSessions() (int, EncoderSessionInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 EncoderSessionInfo
+	}, Return) {
+		r0, r1, ret := t.VgpuInstance.GetEncoderSessions()
+		return struct {
+			r0 int
+			r1 EncoderSessionInfo
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutVgpuInstance) GetEncoderStats() (int, uint32, uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 uint32
+		r2 uint32
+	}, Return) {
+		r0, r1, r2, ret := t.VgpuInstance.GetEncoderStats()
+		return struct {
+			r0 int
+			r1 uint32
+			r2 uint32
+		}{r0, r1, r2}, ret
+	})
+	return r.r0, r.r1, r.r2, ret
+}
+
+func (t timeoutVgpuInstance) GetFBCSessions() (int, FBCSessionInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 int
+		r1 FBCSessionInfo
+	}, Return) {
+		r0, r1, ret := t.VgpuInstance.GetFBCSessions()
+		return struct {
+			r0 int
+			r1 FBCSessionInfo
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutVgpuInstance) GetFBCStats() (FBCStats, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (FBCStats, Return) {
+		return t.VgpuInstance.GetFBCStats()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuInstance) GetFbUsage() (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.VgpuInstance.GetFbUsage()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuInstance) GetFrameRateLimit() (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.VgpuInstance.GetFrameRateLimit()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuInstance) GetGpuInstanceId() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.VgpuInstance.GetGpuInstanceId()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuInstance) GetGpuPciId() (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.VgpuInstance.GetGpuPciId()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuInstance) GetLicenseInfo() (VgpuLicenseInfo, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuLicenseInfo, Return) {
+		return t.VgpuInstance.GetLicenseInfo()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuInstance) GetLicenseStatus() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.VgpuInstance.GetLicenseStatus()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuInstance) GetMdevUUID() (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.VgpuInstance.GetMdevUUID()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuInstance) GetMetadata() (VgpuMetadata, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuMetadata, Return) {
+		return t.VgpuInstance.GetMetadata()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuInstance) GetType() (VgpuTypeId, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuTypeId, Return) {
+		return t.VgpuInstance.GetType()
+	})
+	return wrapVgpuTypeIdWithTimeout(r, t.timeout), ret
+}
+
+func (t timeoutVgpuInstance) GetUUID() (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.VgpuInstance.GetUUID()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuInstance) GetUtilization(p0 uint64) (ValueType, VgpuInstanceUtilizationSample, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 ValueType
+		r1 VgpuInstanceUtilizationSample
+	}, Return) {
+		r0, r1, ret := t.VgpuInstance.GetUtilization(p0)
+		return struct {
+			r0 ValueType
+			r1 VgpuInstanceUtilizationSample
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutVgpuInstance) GetVmDriverVersion() (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.VgpuInstance.GetVmDriverVersion()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuInstance) GetVmID() (string, VgpuVmIdType, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 string
+		r1 VgpuVmIdType
+	}, Return) {
+		r0, r1, ret := t.VgpuInstance.GetVmID()
+		return struct {
+			r0 string
+			r1 VgpuVmIdType
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutVgpuInstance) SetEncoderCapacity(p0 int) Return {
+	return WithTimeout(t.timeout, func() Return {
+		return t.VgpuInstance.SetEncoderCapacity(p0)
+	})
+}
+
+func (t timeoutVgpuTypeId) GetCapabilities(p0 VgpuCapability) (bool, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (bool, Return) {
+		return t.VgpuTypeId.GetCapabilities(p0)
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuTypeId) GetClass() (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.VgpuTypeId.GetClass()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuTypeId) GetCreatablePlacements(p0 Device) (VgpuPlacementList, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuPlacementList, Return) {
+		return t.VgpuTypeId.GetCreatablePlacements(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuTypeId) GetDeviceID() (uint64, uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint64
+		r1 uint64
+	}, Return) {
+		r0, r1, ret := t.VgpuTypeId.GetDeviceID()
+		return struct {
+			r0 uint64
+			r1 uint64
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutVgpuTypeId) GetFrameRateLimit() (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.VgpuTypeId.GetFrameRateLimit()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuTypeId) GetFramebufferSize() (uint64, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint64, Return) {
+		return t.VgpuTypeId.GetFramebufferSize()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuTypeId) GetGpuInstanceProfileId() (uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (uint32, Return) {
+		return t.VgpuTypeId.GetGpuInstanceProfileId()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuTypeId) GetLicense() (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.VgpuTypeId.GetLicense()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuTypeId) GetMaxInstances(p0 Device) (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.VgpuTypeId.GetMaxInstances(unwrapTimeout(p0))
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuTypeId) GetMaxInstancesPerVm() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.VgpuTypeId.GetMaxInstancesPerVm()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuTypeId) GetName() (string, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (string, Return) {
+		return t.VgpuTypeId.GetName()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuTypeId) GetNumDisplayHeads() (int, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (int, Return) {
+		return t.VgpuTypeId.GetNumDisplayHeads()
+	})
+	return r, ret
+}
+
+func (t timeoutVgpuTypeId) GetResolution(p0 int) (uint32, uint32, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (struct {
+		r0 uint32
+		r1 uint32
+	}, Return) {
+		r0, r1, ret := t.VgpuTypeId.GetResolution(p0)
+		return struct {
+			r0 uint32
+			r1 uint32
+		}{r0, r1}, ret
+	})
+	return r.r0, r.r1, ret
+}
+
+func (t timeoutVgpuTypeId) GetSupportedPlacements(p0 Device) (VgpuPlacementList, Return) {
+	r, ret := CallWithTimeout(t.timeout, func() (VgpuPlacementList, Return) {
+		return t.VgpuTypeId.GetSupportedPlacements(unwrapTimeout(p0))
+	})
+	return r, ret
+}